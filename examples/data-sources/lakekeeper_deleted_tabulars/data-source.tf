@@ -0,0 +1,4 @@
+data "lakekeeper_deleted_tabulars" "deleted" {
+  project_id   = "00000000-0000-0000-0000-000000000000"
+  warehouse_id = "a4653498-1dd9-4f12-a2e4-1cc7d4023226"
+}
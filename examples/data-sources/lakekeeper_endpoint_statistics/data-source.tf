@@ -0,0 +1,6 @@
+# calls of the last 7 days answered with an error
+data "lakekeeper_endpoint_statistics" "errors" {
+  project_id   = "00000000-0000-0000-0000-000000000000"
+  interval     = "P7D"
+  status_codes = [400, 403, 404, 500]
+}
@@ -1,3 +1,8 @@
 data "lakekeeper_project" "snapshot" {
   id = "9d25e96e-601a-44ea-badc-74b8c896b4f0"
 }
+
+# projects can also be looked up by name
+data "lakekeeper_project" "analytics" {
+  name = "analytics"
+}
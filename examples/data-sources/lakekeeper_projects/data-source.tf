@@ -0,0 +1,5 @@
+data "lakekeeper_projects" "all" {}
+
+output "project_names" {
+  value = data.lakekeeper_projects.all.projects[*].name
+}
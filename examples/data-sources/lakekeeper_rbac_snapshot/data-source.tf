@@ -0,0 +1,7 @@
+data "lakekeeper_rbac_snapshot" "analytics" {
+  project_id = "f892e96c-1070-45ba-a7b9-3319aaa3532e"
+}
+
+output "rbac" {
+  value = jsondecode(data.lakekeeper_rbac_snapshot.analytics.json)
+}
@@ -2,3 +2,9 @@ data "lakekeeper_role" "admins" {
   project_id = "f892e96c-1070-45ba-a7b9-3319aaa3532e"
   role_id    = "7eec657a-9f86-4b64-b4f0-7e05e62e3c36"
 }
+
+# roles can also be looked up by name
+data "lakekeeper_role" "analysts" {
+  project_id = "f892e96c-1070-45ba-a7b9-3319aaa3532e"
+  name       = "analysts"
+}
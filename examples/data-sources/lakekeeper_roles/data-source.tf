@@ -0,0 +1,9 @@
+data "lakekeeper_roles" "all" {
+  project_id = "f892e96c-1070-45ba-a7b9-3319aaa3532e"
+}
+
+# fuzzy search on the role names
+data "lakekeeper_roles" "analysts" {
+  project_id = "f892e96c-1070-45ba-a7b9-3319aaa3532e"
+  search     = "analyst"
+}
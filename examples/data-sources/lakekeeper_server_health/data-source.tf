@@ -0,0 +1,5 @@
+data "lakekeeper_server_health" "health" {}
+
+output "lakekeeper_healthy" {
+  value = data.lakekeeper_server_health.health.healthy
+}
@@ -1,3 +1,8 @@
 data "lakekeeper_user" "john_doe" {
   id = "oidc~91d18c8-1da4-471e-89f1-6e43eb4dcb38"
 }
+
+# users can also be looked up by name or by email
+data "lakekeeper_user" "jane_doe" {
+  email = "jane.doe@example.com"
+}
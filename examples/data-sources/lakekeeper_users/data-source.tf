@@ -0,0 +1,9 @@
+data "lakekeeper_users" "all" {}
+
+data "lakekeeper_users" "example_com" {
+  email = "@example.com"
+}
+
+data "lakekeeper_users" "peter" {
+  name = "Peter"
+}
@@ -1,4 +1,9 @@
 data "lakekeeper_warehouse" "foo" {
   warehouse_id = "116d3ba8-1c38-4548-b39c-aaed6c325406"
   project_id   = "abbec33d-5a2d-4a55-b454-74f2cc4f391d"
-}
\ No newline at end of file
+}
+# warehouses can also be looked up by name
+data "lakekeeper_warehouse" "bar" {
+  name       = "bar"
+  project_id = "abbec33d-5a2d-4a55-b454-74f2cc4f391d"
+}
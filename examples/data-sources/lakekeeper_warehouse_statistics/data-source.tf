@@ -0,0 +1,9 @@
+data "lakekeeper_warehouse_statistics" "stats" {
+  project_id   = "00000000-0000-0000-0000-000000000000"
+  warehouse_id = "a4653498-1dd9-4f12-a2e4-1cc7d4023226"
+  from         = "2025-01-01T00:00:00Z"
+}
+
+output "max_number_of_tables" {
+  value = max(0, [for s in data.lakekeeper_warehouse_statistics.stats.stats : s.number_of_tables]...)
+}
@@ -0,0 +1,5 @@
+data "lakekeeper_warehouse_tasks" "pending_purges" {
+  warehouse_id = "a4653498-1dd9-4f12-a2e4-1cc7d4023226"
+  statuses     = ["scheduled"]
+  queue_names  = ["tabular_purge"]
+}
@@ -0,0 +1,9 @@
+data "lakekeeper_warehouses" "active" {
+  project_id = "f892e96c-1070-45ba-a7b9-3319aaa3532e"
+}
+
+# also list the deactivated warehouses
+data "lakekeeper_warehouses" "all" {
+  project_id       = "f892e96c-1070-45ba-a7b9-3319aaa3532e"
+  include_inactive = true
+}
@@ -0,0 +1,9 @@
+# lists the namespaces of the warehouse, including the nested ones
+list "lakekeeper_namespace" "all" {
+  provider = lakekeeper
+
+  config {
+    project_id     = "f892e96c-1070-45ba-a7b9-3319aaa3532e"
+    warehouse_name = "production"
+  }
+}
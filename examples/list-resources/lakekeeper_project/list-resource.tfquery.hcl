@@ -0,0 +1,3 @@
+list "lakekeeper_project" "all" {
+  provider = lakekeeper
+}
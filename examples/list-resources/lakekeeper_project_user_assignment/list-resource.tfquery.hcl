@@ -0,0 +1,7 @@
+list "lakekeeper_project_user_assignment" "all" {
+  provider = lakekeeper
+
+  config {
+    project_id = "f892e96c-1070-45ba-a7b9-3319aaa3532e"
+  }
+}
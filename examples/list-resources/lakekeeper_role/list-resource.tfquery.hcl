@@ -0,0 +1,17 @@
+list "lakekeeper_role" "all" {
+  provider = lakekeeper
+
+  config {
+    project_id = "f892e96c-1070-45ba-a7b9-3319aaa3532e"
+  }
+}
+
+# only the roles whose name contains "analyst"
+list "lakekeeper_role" "analysts" {
+  provider = lakekeeper
+
+  config {
+    project_id = "f892e96c-1070-45ba-a7b9-3319aaa3532e"
+    name       = "analyst"
+  }
+}
@@ -0,0 +1,7 @@
+list "lakekeeper_role_role_assignment" "all" {
+  provider = lakekeeper
+
+  config {
+    role_id = "7eec657a-9f86-4b64-b4f0-7e05e62e3c36"
+  }
+}
@@ -0,0 +1,3 @@
+list "lakekeeper_server_role_assignment" "all" {
+  provider = lakekeeper
+}
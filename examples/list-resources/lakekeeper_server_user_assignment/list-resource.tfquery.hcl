@@ -0,0 +1,3 @@
+list "lakekeeper_server_user_assignment" "all" {
+  provider = lakekeeper
+}
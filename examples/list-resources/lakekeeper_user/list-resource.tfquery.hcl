@@ -0,0 +1,3 @@
+list "lakekeeper_user" "all" {
+  provider = lakekeeper
+}
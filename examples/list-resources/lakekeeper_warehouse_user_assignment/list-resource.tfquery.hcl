@@ -0,0 +1,7 @@
+list "lakekeeper_warehouse_user_assignment" "all" {
+  provider = lakekeeper
+
+  config {
+    warehouse_id = "9f3c4f0e-2b8d-4c4a-9d2e-6c1f0b7a8e51"
+  }
+}
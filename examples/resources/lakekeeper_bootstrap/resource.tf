@@ -0,0 +1,5 @@
+resource "lakekeeper_bootstrap" "this" {
+  accept_terms_of_use = true
+  is_operator         = true
+  user_type           = "application"
+}
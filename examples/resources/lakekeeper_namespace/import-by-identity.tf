@@ -0,0 +1,8 @@
+import {
+  to = lakekeeper_namespace.example
+  identity = {
+    project_id     = "891d18c8-1da4-471e-89f1-6e43eb4dcb38"
+    warehouse_name = "warehouse_example"
+    name           = "my_namespace"
+  }
+}
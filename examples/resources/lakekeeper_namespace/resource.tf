@@ -2,4 +2,14 @@ resource "lakekeeper_namespace" "example" {
   project_id     = "891d18c8-1da4-471e-89f1-6e43eb4dcb38"
   warehouse_name = "warehouse_example"
   name           = "namespace_name"
+  protected      = true
+}
+
+# an ephemeral namespace, dropped along with its tables and their data
+resource "lakekeeper_namespace" "ephemeral" {
+  project_id       = "891d18c8-1da4-471e-89f1-6e43eb4dcb38"
+  warehouse_name   = "warehouse_example"
+  name             = "pr_1234"
+  recursive_delete = true
+  purge            = true
 }
@@ -0,0 +1,6 @@
+import {
+  to = lakekeeper_project.test
+  identity = {
+    project_id = "891d18c8-1da4-471e-89f1-6e43eb4dcb38"
+  }
+}
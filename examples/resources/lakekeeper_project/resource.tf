@@ -1,3 +1,10 @@
 resource "lakekeeper_project" "example" {
   name = "project-name"
 }
+
+# the same project ID in every environment
+resource "lakekeeper_project" "analytics" {
+  project_id                     = "f892e96c-1070-45ba-a7b9-3319aaa3532e"
+  name                           = "analytics"
+  prevent_destroy_when_not_empty = true
+}
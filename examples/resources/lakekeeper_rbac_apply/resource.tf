@@ -0,0 +1,22 @@
+provider "lakekeeper" {
+  alias    = "blue"
+  endpoint = "https://blue.lakekeeper.example.com"
+}
+
+provider "lakekeeper" {
+  alias    = "green"
+  endpoint = "https://green.lakekeeper.example.com"
+}
+
+data "lakekeeper_rbac_snapshot" "blue" {
+  provider   = lakekeeper.blue
+  project_id = "f892e96c-1070-45ba-a7b9-3319aaa3532e"
+}
+
+# mirror the roles and the assignments of the blue project on the green one
+resource "lakekeeper_rbac_apply" "green" {
+  provider   = lakekeeper.green
+  project_id = "9b1c7c4e-5f0e-4a8a-8a53-1d2f3c4b5a69"
+  snapshot   = data.lakekeeper_rbac_snapshot.blue.json
+  prune      = true
+}
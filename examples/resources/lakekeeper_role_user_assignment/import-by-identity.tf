@@ -0,0 +1,7 @@
+import {
+  to = lakekeeper_role_user_assignment.data_analysts
+  identity = {
+    role_id = "a4653498-1dd9-4f12-a2e4-1cc7d4023226"
+    user_id = "oidc~91d18c8-1da4-471e-89f1-6e43eb4dcb38"
+  }
+}
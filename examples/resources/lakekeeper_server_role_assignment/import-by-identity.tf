@@ -0,0 +1,6 @@
+import {
+  to = lakekeeper_server_role_assignment.data_analysts
+  identity = {
+    role_id = "cb6ee351-68ff-4299-87f2-876964f6d8dd"
+  }
+}
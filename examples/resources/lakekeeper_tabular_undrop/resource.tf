@@ -0,0 +1,16 @@
+data "lakekeeper_deleted_tabulars" "deleted" {
+  project_id   = "00000000-0000-0000-0000-000000000000"
+  warehouse_id = "a4653498-1dd9-4f12-a2e4-1cc7d4023226"
+}
+
+# restore every soft-deleted table of the namespace `sales`
+resource "lakekeeper_tabular_undrop" "sales" {
+  project_id   = data.lakekeeper_deleted_tabulars.deleted.project_id
+  warehouse_id = data.lakekeeper_deleted_tabulars.deleted.warehouse_id
+  tabulars = [
+    for t in data.lakekeeper_deleted_tabulars.deleted.tabulars : {
+      id   = t.id
+      type = t.type
+    } if t.type == "table" && t.namespace == ["sales"]
+  ]
+}
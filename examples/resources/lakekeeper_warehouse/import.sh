@@ -1,2 +1,5 @@
 # id is "{{project_id}}/{{warehouse_id}}"
+# The storage credential is never returned by Lakekeeper: the credential of the
+# configuration is recorded in the state by the next apply, without being sent.
+# Change `credential_version` to send it as well.
 terraform import lakekeeper_warehouse.s3 "261bd4e4-5c57-4707-96ad-44128f9038c0/37cf8760-5092-4d5d-8b29-a6b46a45f067"
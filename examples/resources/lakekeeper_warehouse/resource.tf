@@ -9,6 +9,10 @@ resource "lakekeeper_warehouse" "aws" {
   protected      = false
   active         = true
   managed_access = true
+  # bump to rotate the storage credential without touching the storage profile
+  credential_version = 1
+  # check the access to the bucket during the plan
+  validate_storage = true
   storage_profile = {
     s3 = {
       region = "us-east-1"
@@ -67,3 +71,29 @@ resource "lakekeeper_warehouse" "adls" {
     }
   }
 }
+
+# Create a warehouse: S3 storage with a write-only secret, never stored in the state (Terraform >= 1.11)
+resource "lakekeeper_warehouse" "aws_write_only" {
+  project_id = lakekeeper_project.bi.id
+  name       = "aws-write-only"
+  storage_profile = {
+    s3 = {
+      region = "us-east-1"
+      bucket = "mybucket"
+      credential = {
+        access_key = {
+          access_key_id        = "AKIAEXAMPLE1234567890"
+          secret_access_key_wo = var.secret_access_key
+          # bump to send a new value of secret_access_key_wo
+          secret_access_key_wo_version = 1
+        }
+      }
+    }
+  }
+}
+
+variable "secret_access_key" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
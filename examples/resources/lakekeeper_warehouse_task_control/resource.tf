@@ -0,0 +1,12 @@
+data "lakekeeper_warehouse_tasks" "pending_purges" {
+  warehouse_id = "a4653498-1dd9-4f12-a2e4-1cc7d4023226"
+  statuses     = ["scheduled"]
+  queue_names  = ["tabular_purge"]
+}
+
+# run all the pending purges now
+resource "lakekeeper_warehouse_task_control" "run_purges" {
+  warehouse_id = data.lakekeeper_warehouse_tasks.pending_purges.warehouse_id
+  action       = "run-now"
+  task_ids     = [for t in data.lakekeeper_warehouse_tasks.pending_purges.tasks : t.task_id]
+}
@@ -0,0 +1,7 @@
+import {
+  to = lakekeeper_warehouse_task_queue_config.expiration
+  identity = {
+    warehouse_id = "a4653498-1dd9-4f12-a2e4-1cc7d4023226"
+    queue_name   = "tabular_expiration"
+  }
+}
@@ -0,0 +1,2 @@
+# you can import a task queue configuration by its id in the form <warehouse_id>/<queue_name>
+terraform import lakekeeper_warehouse_task_queue_config.expiration "a4653498-1dd9-4f12-a2e4-1cc7d4023226/tabular_expiration"
@@ -0,0 +1,11 @@
+resource "lakekeeper_warehouse_task_queue_config" "expiration" {
+  warehouse_id                     = "a4653498-1dd9-4f12-a2e4-1cc7d4023226"
+  queue_name                       = "tabular_expiration"
+  max_seconds_since_last_heartbeat = 3600
+  max_retries                      = 5
+  num_workers                      = 2
+  poll_interval_seconds            = 30
+
+  # settings specific to the queue
+  queue_config = jsonencode({})
+}
@@ -23,6 +23,7 @@ type Config struct {
 	ClientTimeout    int
 	UserAgent        string
 	InitialBootstrap bool
+	ReadOnly         bool
 
 	OIDCClientConfig
 }
@@ -39,30 +40,11 @@ func (c *Config) NewLakekeeperClient(ctx context.Context) (*lakekeeper.Client, e
 		return nil, errors.New("no OIDC Server URI configured, either use the `oidc_server_uri` provider argument or set it as `LAKEKEEPER_AUTH_URL` environment variable")
 	}
 
-	// Configure TLS/SSL
-	tlsConfig := &tls.Config{}
-
-	// If a CACertFile has been specified, use that for cert validation
-	if c.CACertFile != "" {
-		caCert, err := os.ReadFile(c.CACertFile)
-		if err != nil {
-			return nil, err
-		}
-
-		caCertPool := x509.NewCertPool()
-		caCertPool.AppendCertsFromPEM(caCert)
-		tlsConfig.RootCAs = caCertPool
-	}
-
-	// If configured as insecure, turn off SSL verification
-	if c.Insecure {
-		tlsConfig.InsecureSkipVerify = true
+	t, err := c.NewTransport()
+	if err != nil {
+		return nil, err
 	}
 
-	t := http.DefaultTransport.(*http.Transport).Clone()
-	t.TLSClientConfig = tlsConfig
-	t.MaxIdleConnsPerHost = 100
-
 	opts := []lakekeeper.ClientOptionFunc{
 		lakekeeper.WithHTTPClient(
 			&http.Client{
@@ -102,3 +84,38 @@ func (c *Config) NewLakekeeperClient(ctx context.Context) (*lakekeeper.Client, e
 
 	return client, nil
 }
+
+// NewTransport returns the HTTP transport used to communicate with the Lakekeeper
+// management and catalog APIs.
+func (c *Config) NewTransport() (http.RoundTripper, error) {
+	// Configure TLS/SSL
+	tlsConfig := &tls.Config{}
+
+	// If a CACertFile has been specified, use that for cert validation
+	if c.CACertFile != "" {
+		caCert, err := os.ReadFile(c.CACertFile)
+		if err != nil {
+			return nil, err
+		}
+
+		caCertPool := x509.NewCertPool()
+		caCertPool.AppendCertsFromPEM(caCert)
+		tlsConfig.RootCAs = caCertPool
+	}
+
+	// If configured as insecure, turn off SSL verification
+	if c.Insecure {
+		tlsConfig.InsecureSkipVerify = true
+	}
+
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.TLSClientConfig = tlsConfig
+	t.MaxIdleConnsPerHost = 100
+
+	// If configured as read-only, reject any request modifying the server
+	if c.ReadOnly {
+		return NewReadOnlyTransport(t), nil
+	}
+
+	return t, nil
+}
@@ -0,0 +1,74 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	managementv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1"
+)
+
+type (
+	// ServerHealth represents the response of the Lakekeeper health endpoint.
+	ServerHealth struct {
+		Health   string                     `json:"health"`
+		Services map[string][]ServiceHealth `json:"services"`
+
+		// StatusCode is the HTTP status code returned by the health endpoint.
+		StatusCode int `json:"-"`
+	}
+
+	// ServiceHealth represents the health of one of the services used by Lakekeeper.
+	ServiceHealth struct {
+		Name      string `json:"name"`
+		Status    string `json:"status"`
+		CheckedAt string `json:"checked_at"`
+	}
+)
+
+// HealthStatusOK is the status reported by a healthy server or service.
+const HealthStatusOK = "ok"
+
+// IsHealthy returns true if the server reports itself as healthy.
+func (h *ServerHealth) IsHealthy() bool {
+	return h.StatusCode == http.StatusOK && h.Health == HealthStatusOK
+}
+
+// GetServerHealth calls the health endpoint of the Lakekeeper server.
+// managementURL is the base URL of the management API, as returned by the Lakekeeper client.
+func GetServerHealth(ctx context.Context, httpClient *http.Client, managementURL *url.URL) (*ServerHealth, error) {
+	u := *managementURL
+	u.Path = strings.TrimSuffix(u.Path, managementv1.APIManagementVersionPath) + "/health"
+	u.RawPath = ""
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// An unhealthy server answers with a non 2xx status code
+	// but still describes the health of its services.
+	health := ServerHealth{}
+	if err := json.Unmarshal(body, &health); err != nil {
+		return nil, fmt.Errorf("unexpected health response, status=%s body=%s", resp.Status, string(body))
+	}
+	health.StatusCode = resp.StatusCode
+
+	return &health, nil
+}
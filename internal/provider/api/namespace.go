@@ -0,0 +1,145 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/apache/iceberg-go/catalog"
+	managementv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1"
+	"github.com/baptistegh/go-lakekeeper/pkg/core"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// catalogVersionPath is the path of the Iceberg REST catalog API, next to the management API.
+const catalogVersionPath = "/catalog/v1"
+
+// DropNamespaceOptions represents the options of DropNamespace(), which are
+// Lakekeeper extensions of the Iceberg REST catalog API.
+//
+// Lakekeeper API docs:
+// https://docs.lakekeeper.io/docs/nightly/api/catalog/#tag/Catalog-API/operation/dropNamespace
+type DropNamespaceOptions struct {
+	// Force drops the namespace even if it is protected.
+	Force bool `url:"force,omitempty"`
+	// Recursive drops the tables, views and namespaces of the namespace.
+	Recursive bool `url:"recursive,omitempty"`
+	// Purge deletes the data of the dropped tables.
+	Purge bool `url:"purge,omitempty"`
+}
+
+// DropNamespace drops a namespace of a warehouse. The Iceberg client of the
+// catalog cannot send the options, the request is sent with the management client.
+func DropNamespace(ctx context.Context, client core.Client, warehouseID string, namespace []string, opt *DropNamespaceOptions) (*http.Response, error) {
+	// the levels of a namespace are separated by the unit separator character
+	path := fmt.Sprintf("/%s/namespaces/%s", url.PathEscape(warehouseID), url.PathEscape(strings.Join(namespace, "\x1f")))
+
+	req, err := client.NewRequest(ctx, http.MethodDelete, path, opt, []core.RequestOptionFunc{withCatalogPath()})
+	if err != nil {
+		return nil, err
+	}
+
+	r, apiErr := client.Do(req, nil)
+	if apiErr != nil {
+		return r, apiErr
+	}
+
+	return r, nil
+}
+
+type (
+	// ListNamespacesOptions represents ListNamespaces() options.
+	//
+	// Lakekeeper API docs:
+	// https://docs.lakekeeper.io/docs/nightly/api/catalog/#tag/Catalog-API/operation/listNamespaces
+	ListNamespacesOptions struct {
+		Parent      []string `url:"-"`
+		PageToken   *string  `url:"pageToken,omitempty"`
+		PageSize    *int64   `url:"pageSize,omitempty"`
+		ReturnUUIDs bool     `url:"returnUuids,omitempty"`
+	}
+
+	// ListNamespacesResponse represents one page of namespaces.
+	ListNamespacesResponse struct {
+		Namespaces     [][]string `json:"namespaces"`
+		NamespaceUUIDs []string   `json:"namespace-uuids,omitempty"`
+		NextPageToken  *string    `json:"next-page-token,omitempty"`
+	}
+
+	// listNamespacesQuery adds the parent namespace to the query parameters,
+	// its levels are separated by the unit separator character.
+	listNamespacesQuery struct {
+		*ListNamespacesOptions
+		Parent string `url:"parent,omitempty"`
+	}
+)
+
+// ListNamespaces lists one page of the namespaces of a warehouse, under the parent of the options.
+func ListNamespaces(ctx context.Context, client core.Client, warehouseID string, opt *ListNamespacesOptions) (*ListNamespacesResponse, *http.Response, error) {
+	if opt == nil {
+		opt = &ListNamespacesOptions{}
+	}
+
+	query := &listNamespacesQuery{ListNamespacesOptions: opt, Parent: strings.Join(opt.Parent, "\x1f")}
+
+	req, err := client.NewRequest(ctx, http.MethodGet, fmt.Sprintf("/%s/namespaces", url.PathEscape(warehouseID)), query, []core.RequestOptionFunc{withCatalogPath()})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var resp ListNamespacesResponse
+
+	r, apiErr := client.Do(req, &resp)
+	if apiErr != nil {
+		return nil, r, apiErr
+	}
+
+	return &resp, r, nil
+}
+
+// GetNamespaceID returns the ID of a namespace of a warehouse, which is required by the
+// management API. The Iceberg REST catalog API only returns it when listing namespaces.
+func GetNamespaceID(ctx context.Context, client core.Client, warehouseID string, namespace []string) (string, error) {
+	if len(namespace) == 0 {
+		return "", fmt.Errorf("%w: empty namespace", catalog.ErrNoSuchNamespace)
+	}
+
+	opt := &ListNamespacesOptions{
+		Parent:      namespace[:len(namespace)-1],
+		ReturnUUIDs: true,
+	}
+
+	for {
+		resp, _, err := ListNamespaces(ctx, client, warehouseID, opt)
+		if err != nil {
+			return "", err
+		}
+
+		if len(resp.NamespaceUUIDs) != len(resp.Namespaces) {
+			return "", fmt.Errorf("the server did not return the namespace IDs")
+		}
+
+		for i, ns := range resp.Namespaces {
+			if slices.Equal(ns, namespace) {
+				return resp.NamespaceUUIDs[i], nil
+			}
+		}
+
+		if resp.NextPageToken == nil || *resp.NextPageToken == "" || len(resp.Namespaces) == 0 {
+			return "", fmt.Errorf("%w: %s", catalog.ErrNoSuchNamespace, strings.Join(namespace, "."))
+		}
+		opt.PageToken = resp.NextPageToken
+	}
+}
+
+// withCatalogPath sends the request to the catalog API instead of the management API.
+func withCatalogPath() core.RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		req.URL.Path = strings.Replace(req.URL.Path, managementv1.APIManagementVersionPath, catalogVersionPath, 1)
+		req.URL.RawPath = strings.Replace(req.URL.RawPath, managementv1.APIManagementVersionPath, catalogVersionPath, 1)
+		return nil
+	}
+}
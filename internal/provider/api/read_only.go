@@ -0,0 +1,54 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+)
+
+// ErrReadOnly is returned for every request rejected by the read-only transport.
+var ErrReadOnly = errors.New("the provider is configured in read-only mode")
+
+// readOnlyQueryPaths are the endpoints which are only reading data
+// even if they are exposed with the POST method.
+var readOnlyQueryPaths = []*regexp.Regexp{
+	regexp.MustCompile(`/management/v1/search/role$`),
+	regexp.MustCompile(`/management/v1/endpoint-statistics$`),
+	regexp.MustCompile(`/management/v1/warehouse/[^/]+/task/list$`),
+	regexp.MustCompile(`/management/v1/validate-storage$`),
+}
+
+// readOnlyTransport is an http.RoundTripper rejecting any request
+// which could modify the state of the Lakekeeper server.
+type readOnlyTransport struct {
+	next http.RoundTripper
+}
+
+// NewReadOnlyTransport wraps the given transport so that only
+// read requests are sent to the server.
+func NewReadOnlyTransport(next http.RoundTripper) http.RoundTripper {
+	return &readOnlyTransport{next: next}
+}
+
+func (t *readOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if isReadOnlyRequest(req) {
+		return t.next.RoundTrip(req)
+	}
+
+	return nil, fmt.Errorf("%w, refusing to send %s %s. Unset `read_only` and `LAKEKEEPER_READ_ONLY` to allow changes", ErrReadOnly, req.Method, req.URL.Path)
+}
+
+func isReadOnlyRequest(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	case http.MethodPost:
+		return slices.ContainsFunc(readOnlyQueryPaths, func(p *regexp.Regexp) bool {
+			return p.MatchString(req.URL.Path)
+		})
+	default:
+		return false
+	}
+}
@@ -0,0 +1,22 @@
+package api
+
+import (
+	"github.com/baptistegh/go-lakekeeper/pkg/core"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// WithoutEmptyQueryParams removes the query parameters without value from the request.
+// Some options of the Lakekeeper client are encoded even when they are not set,
+// which the server rejects as invalid values.
+func WithoutEmptyQueryParams() core.RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		q := req.URL.Query()
+		for k, v := range q {
+			if len(v) == 0 || (len(v) == 1 && v[0] == "") {
+				q.Del(k)
+			}
+		}
+		req.URL.RawQuery = q.Encode()
+		return nil
+	}
+}
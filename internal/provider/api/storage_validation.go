@@ -0,0 +1,41 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	managementv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1"
+	"github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1/storage/credential"
+	"github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1/storage/profile"
+	"github.com/baptistegh/go-lakekeeper/pkg/core"
+)
+
+// ValidateStorageOptions represents ValidateStorage() options.
+//
+// Lakekeeper API docs:
+// https://docs.lakekeeper.io/docs/nightly/api/management/#tag/warehouse/operation/validate_storage
+type ValidateStorageOptions struct {
+	StorageProfile    profile.StorageProfile        `json:"storage-profile"`
+	StorageCredential *credential.StorageCredential `json:"storage-credential,omitempty"`
+}
+
+// ValidateStorage checks that Lakekeeper is able to access the storage
+// described by the given profile and credential, without creating a warehouse.
+func ValidateStorage(ctx context.Context, client core.Client, projectID string, opt *ValidateStorageOptions) (*http.Response, error) {
+	var options []core.RequestOptionFunc
+	if projectID != "" {
+		options = append(options, managementv1.WithProject(projectID))
+	}
+
+	req, err := client.NewRequest(ctx, http.MethodPost, "/validate-storage", opt, options)
+	if err != nil {
+		return nil, err
+	}
+
+	r, apiErr := client.Do(req, nil)
+	if apiErr != nil {
+		return r, apiErr
+	}
+
+	return r, nil
+}
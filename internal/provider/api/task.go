@@ -0,0 +1,173 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/baptistegh/go-lakekeeper/pkg/core"
+)
+
+type (
+	// TaskStatus is the status of a warehouse task.
+	TaskStatus string
+
+	// TaskControlActionType is an action which can be applied on warehouse tasks.
+	TaskControlActionType string
+
+	// Task represents a task scheduled by Lakekeeper for a warehouse.
+	//
+	// Lakekeeper API docs:
+	// https://docs.lakekeeper.io/docs/nightly/api/management/#tag/tasks/operation/list_tasks
+	Task struct {
+		TaskID          string     `json:"task-id"`
+		WarehouseID     string     `json:"warehouse-id"`
+		QueueName       string     `json:"queue-name"`
+		Entity          TaskEntity `json:"entity"`
+		EntityName      []string   `json:"entity-name"`
+		Status          TaskStatus `json:"status"`
+		Attempt         int64      `json:"attempt"`
+		Progress        float64    `json:"progress"`
+		ParentTaskID    *string    `json:"parent-task-id,omitempty"`
+		ScheduledFor    *time.Time `json:"scheduled-for,omitempty"`
+		StartedAt       *time.Time `json:"started-at,omitempty"`
+		LastHeartbeatAt *time.Time `json:"last-heartbeat-at,omitempty"`
+		CreatedAt       *time.Time `json:"created-at,omitempty"`
+		UpdatedAt       *time.Time `json:"updated-at,omitempty"`
+	}
+
+	// TaskEntity is the entity a task applies to.
+	TaskEntity struct {
+		Type    string  `json:"type"`
+		TableID *string `json:"table-id,omitempty"`
+		ViewID  *string `json:"view-id,omitempty"`
+	}
+
+	// ListTasksOptions represents ListTasks() options.
+	//
+	// Lakekeeper API docs:
+	// https://docs.lakekeeper.io/docs/nightly/api/management/#tag/tasks/operation/list_tasks
+	ListTasksOptions struct {
+		Status        []TaskStatus `json:"status,omitempty"`
+		QueueName     []string     `json:"queue-name,omitempty"`
+		CreatedAfter  *time.Time   `json:"created-after,omitempty"`
+		CreatedBefore *time.Time   `json:"created-before,omitempty"`
+		PageToken     *string      `json:"page-token,omitempty"`
+		PageSize      *int64       `json:"page-size,omitempty"`
+	}
+
+	// ListTasksResponse represents ListTasks() response.
+	ListTasksResponse struct {
+		Tasks         []*Task `json:"tasks"`
+		NextPageToken *string `json:"next-page-token,omitempty"`
+	}
+
+	// ControlTasksOptions represents ControlTasks() options.
+	//
+	// Lakekeeper API docs:
+	// https://docs.lakekeeper.io/docs/nightly/api/management/#tag/tasks/operation/control_tasks
+	ControlTasksOptions struct {
+		Action  TaskControlAction `json:"action"`
+		TaskIDs []string          `json:"task-ids"`
+	}
+
+	// TaskControlAction is the action sent by ControlTasks().
+	TaskControlAction struct {
+		ActionType   TaskControlActionType `json:"action-type"`
+		ScheduledFor *time.Time            `json:"scheduled-for,omitempty"`
+	}
+)
+
+const (
+	TaskStatusScheduled TaskStatus = "scheduled"
+	TaskStatusRunning   TaskStatus = "running"
+	TaskStatusStopping  TaskStatus = "stopping"
+	TaskStatusCancelled TaskStatus = "cancelled"
+	TaskStatusSuccess   TaskStatus = "success"
+	TaskStatusFailed    TaskStatus = "failed"
+
+	TaskControlActionStop   TaskControlActionType = "stop"
+	TaskControlActionCancel TaskControlActionType = "cancel"
+	TaskControlActionRunNow TaskControlActionType = "run-now"
+	TaskControlActionRunAt  TaskControlActionType = "run-at"
+)
+
+// ValidTaskStatuses lists all the statuses a task can have.
+var ValidTaskStatuses = []TaskStatus{
+	TaskStatusScheduled,
+	TaskStatusRunning,
+	TaskStatusStopping,
+	TaskStatusCancelled,
+	TaskStatusSuccess,
+	TaskStatusFailed,
+}
+
+// ValidTaskControlActions lists all the actions which can be applied on tasks.
+var ValidTaskControlActions = []TaskControlActionType{
+	TaskControlActionStop,
+	TaskControlActionCancel,
+	TaskControlActionRunNow,
+	TaskControlActionRunAt,
+}
+
+// ListTasks lists one page of the tasks of a warehouse.
+func ListTasks(ctx context.Context, client core.Client, warehouseID string, opt *ListTasksOptions) (*ListTasksResponse, *http.Response, error) {
+	if opt == nil {
+		opt = &ListTasksOptions{}
+	}
+
+	req, err := client.NewRequest(ctx, http.MethodPost, fmt.Sprintf("/warehouse/%s/task/list", url.PathEscape(warehouseID)), opt, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var resp ListTasksResponse
+
+	r, apiErr := client.Do(req, &resp)
+	if apiErr != nil {
+		return nil, r, apiErr
+	}
+
+	return &resp, r, nil
+}
+
+// ListAllTasks lists the tasks of a warehouse, following the pagination.
+func ListAllTasks(ctx context.Context, client core.Client, warehouseID string, opt *ListTasksOptions) ([]*Task, error) {
+	if opt == nil {
+		opt = &ListTasksOptions{}
+	}
+	// do not modify the caller options while paginating
+	page := *opt
+
+	var tasks []*Task
+	for {
+		resp, _, err := ListTasks(ctx, client, warehouseID, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		tasks = append(tasks, resp.Tasks...)
+
+		if resp.NextPageToken == nil || *resp.NextPageToken == "" || len(resp.Tasks) == 0 {
+			return tasks, nil
+		}
+		page.PageToken = resp.NextPageToken
+	}
+}
+
+// ControlTasks applies an action on tasks of a warehouse.
+func ControlTasks(ctx context.Context, client core.Client, warehouseID string, opt *ControlTasksOptions) (*http.Response, error) {
+	req, err := client.NewRequest(ctx, http.MethodPost, fmt.Sprintf("/warehouse/%s/task/control", url.PathEscape(warehouseID)), opt, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r, apiErr := client.Do(req, nil)
+	if apiErr != nil {
+		return r, apiErr
+	}
+
+	return r, nil
+}
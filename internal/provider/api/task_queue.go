@@ -0,0 +1,69 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/baptistegh/go-lakekeeper/pkg/core"
+)
+
+type (
+	// TaskQueueConfig represents the configuration of a task queue for a warehouse.
+	//
+	// Lakekeeper API docs:
+	// https://docs.lakekeeper.io/docs/nightly/api/management/#tag/tasks/operation/get_task_queue_config
+	TaskQueueConfig struct {
+		QueueName string `json:"queue-name,omitempty"`
+		// QueueConfig is specific to each queue, it is kept as raw JSON.
+		QueueConfig                  json.RawMessage `json:"queue-config"`
+		MaxSecondsSinceLastHeartbeat *int64          `json:"max-seconds-since-last-heartbeat,omitempty"`
+	}
+
+	// SetTaskQueueConfigOptions represents SetTaskQueueConfig() options.
+	//
+	// Lakekeeper API docs:
+	// https://docs.lakekeeper.io/docs/nightly/api/management/#tag/tasks/operation/set_task_queue_config
+	SetTaskQueueConfigOptions struct {
+		QueueConfig                  json.RawMessage `json:"queue-config"`
+		MaxSecondsSinceLastHeartbeat *int64          `json:"max-seconds-since-last-heartbeat"`
+	}
+)
+
+// GetTaskQueueConfig retrieves the configuration of a task queue for a warehouse.
+func GetTaskQueueConfig(ctx context.Context, client core.Client, warehouseID, queueName string) (*TaskQueueConfig, *http.Response, error) {
+	req, err := client.NewRequest(ctx, http.MethodGet, taskQueueConfigPath(warehouseID, queueName), nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var config TaskQueueConfig
+
+	r, apiErr := client.Do(req, &config)
+	if apiErr != nil {
+		return nil, r, apiErr
+	}
+
+	return &config, r, nil
+}
+
+// SetTaskQueueConfig configures a task queue for a warehouse.
+func SetTaskQueueConfig(ctx context.Context, client core.Client, warehouseID, queueName string, opt *SetTaskQueueConfigOptions) (*http.Response, error) {
+	req, err := client.NewRequest(ctx, http.MethodPost, taskQueueConfigPath(warehouseID, queueName), opt, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r, apiErr := client.Do(req, nil)
+	if apiErr != nil {
+		return r, apiErr
+	}
+
+	return r, nil
+}
+
+func taskQueueConfigPath(warehouseID, queueName string) string {
+	return fmt.Sprintf("/warehouse/%s/task-queue/%s/config", url.PathEscape(warehouseID), url.PathEscape(queueName))
+}
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	managementv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1"
+	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
+	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &lakekeeperDeletedTabularsDataSource{}
+	_ datasource.DataSourceWithConfigure = &lakekeeperDeletedTabularsDataSource{}
+)
+
+func init() {
+	registerDataSource(NewLakekeeperDeletedTabularsDataSource)
+}
+
+// NewLakekeeperDeletedTabularsDataSource is a helper function to simplify the provider implementation.
+func NewLakekeeperDeletedTabularsDataSource() datasource.DataSource {
+	return &lakekeeperDeletedTabularsDataSource{}
+}
+
+// lakekeeperDeletedTabularsDataSource is the data source implementation.
+type lakekeeperDeletedTabularsDataSource struct {
+	client *lakekeeper.Client
+}
+
+// lakekeeperDeletedTabularsDataSourceModel describes the data source data model.
+type lakekeeperDeletedTabularsDataSourceModel struct {
+	ID          types.String                    `tfsdk:"id"` // form: project_id/warehouse_id (internal ID)
+	ProjectID   types.String                    `tfsdk:"project_id"`
+	WarehouseID types.String                    `tfsdk:"warehouse_id"`
+	NamespaceID types.String                    `tfsdk:"namespace_id"`
+	Tabulars    []lakekeeperDeletedTabularModel `tfsdk:"tabulars"`
+}
+
+// lakekeeperDeletedTabularModel describes a soft-deleted table or view.
+type lakekeeperDeletedTabularModel struct {
+	ID             types.String   `tfsdk:"id"`
+	Name           types.String   `tfsdk:"name"`
+	Namespace      []types.String `tfsdk:"namespace"`
+	Type           types.String   `tfsdk:"type"`
+	ExpirationDate types.String   `tfsdk:"expiration_date"`
+	DeletedAt      types.String   `tfsdk:"deleted_at"`
+	CreatedAt      types.String   `tfsdk:"created_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *lakekeeperDeletedTabularsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deleted_tabulars"
+}
+
+// Schema defines the schema for the data source.
+func (d *lakekeeperDeletedTabularsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`lakekeeper_deleted_tabulars`" + ` data source lists the soft-deleted tables and views of a warehouse. They can be restored with the ` + "`lakekeeper_tabular_undrop`" + ` resource until their expiration date.
+
+Tables and views are only soft-deleted when the warehouse uses a ` + "`soft`" + ` delete profile.
+
+**Upstream API**: [Lakekeeper REST API docs](https://docs.lakekeeper.io/docs/nightly/api/management/#tag/warehouse/operation/list_deleted_tabulars)`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The internal ID of this data source. In the form: {{project_id}}/{{warehouse_id}}",
+				Computed:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The project ID to which the warehouse belongs.",
+				Required:            true,
+			},
+			"warehouse_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the warehouse.",
+				Required:            true,
+			},
+			"namespace_id": schema.StringAttribute{
+				MarkdownDescription: "Only list the tables and views of this namespace.",
+				Optional:            true,
+			},
+			"tabulars": schema.ListNestedAttribute{
+				MarkdownDescription: "List of soft-deleted tables and views.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "ID of the table or view.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the table or view.",
+							Computed:            true,
+						},
+						"namespace": schema.ListAttribute{
+							MarkdownDescription: "Namespace parts the table or view belongs to.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: fmt.Sprintf("Either `%s` or `%s`.", managementv1.TableTabularType, managementv1.ViewTabularType),
+							Computed:            true,
+						},
+						"expiration_date": schema.StringAttribute{
+							MarkdownDescription: "Date when the table or view will not be recoverable anymore.",
+							Computed:            true,
+						},
+						"deleted_at": schema.StringAttribute{
+							MarkdownDescription: "Date when the table or view was deleted.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Date when the table or view was created.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *lakekeeperDeletedTabularsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	datasource := req.ProviderData.(*LakekeeperDatasourceData)
+	d.client = datasource.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *lakekeeperDeletedTabularsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state lakekeeperDeletedTabularsDataSourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := state.ProjectID.ValueString()
+	warehouseID := state.WarehouseID.ValueString()
+
+	opts := managementv1.ListSoftDeletedTabularsOptions{
+		NamespaceID: state.NamespaceID.ValueStringPointer(),
+	}
+
+	state.Tabulars = []lakekeeperDeletedTabularModel{}
+	for {
+		// the namespace filter is sent empty when not set
+		page, _, err := d.client.WarehouseV1(projectID).ListSoftDeletedTabulars(ctx, warehouseID, &opts, api.WithoutEmptyQueryParams())
+		if err != nil {
+			resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to list deleted tabulars for warehouse %s, %v", warehouseID, err))
+			return
+		}
+
+		for _, t := range page.Tabulars {
+			state.Tabulars = append(state.Tabulars, flattenDeletedTabular(t))
+		}
+
+		if page.NextPageToken == nil || *page.NextPageToken == "" || len(page.Tabulars) == 0 {
+			break
+		}
+		opts.PageToken = page.NextPageToken
+	}
+
+	state.ID = types.StringValue(fmt.Sprintf("%s/%s", projectID, warehouseID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func flattenDeletedTabular(t *managementv1.Tabular) lakekeeperDeletedTabularModel {
+	namespace := []types.String{}
+	for _, n := range t.Namespace {
+		namespace = append(namespace, types.StringValue(n))
+	}
+
+	return lakekeeperDeletedTabularModel{
+		ID:             types.StringValue(t.ID),
+		Name:           types.StringValue(t.Name),
+		Namespace:      namespace,
+		Type:           types.StringValue(string(t.Type)),
+		ExpirationDate: types.StringValue(t.ExpirationDate),
+		DeletedAt:      types.StringValue(t.DeletedAt),
+		CreatedAt:      types.StringValue(t.CreatedAt),
+	}
+}
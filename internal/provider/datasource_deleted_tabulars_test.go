@@ -0,0 +1,121 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/testutil"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataLakekeeperDeletedTabulars_basic(t *testing.T) {
+	project := testutil.CreateProject(t)
+
+	keyPrefix := fmt.Sprintf("key-prefix-%d", rand.Int())
+	warehouse := testutil.CreateWarehouse(t, project.ID, keyPrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				data "lakekeeper_deleted_tabulars" "foo" {
+					project_id = "%s"
+					warehouse_id = "%s"
+				}
+				`, project.ID, warehouse.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakekeeper_deleted_tabulars.foo", "id", project.ID+"/"+warehouse.ID),
+					resource.TestCheckResourceAttr("data.lakekeeper_deleted_tabulars.foo", "tabulars.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataLakekeeperDeletedTabulars_mock(t *testing.T) {
+	mockLakekeeperServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/token" && r.Method == "POST":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			// nolint - don't need to err check writing the response in the test
+			w.Write([]byte(`{"access_token": "SlAV32hkKG", "token_type": "Bearer", "expires_in": 3600}`))
+		case r.URL.Path == "/management/v1/warehouse/w1/deleted-tabulars" && r.Method == "GET":
+			if r.URL.Query().Has("namespaceId") {
+				t.Errorf("unexpected empty namespace filter, got %s", r.URL.RawQuery)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			if r.URL.Query().Get("pageToken") == "" {
+				// nolint - don't need to err check writing the response in the test
+				w.Write([]byte(`{
+					"tabulars": [{
+						"id": "t1",
+						"name": "orders",
+						"warehouse-id": "w1",
+						"namespace": ["sales"],
+						"typ": "table",
+						"expiration-date": "2025-01-08T09:00:00Z",
+						"deleted-at": "2025-01-01T09:00:00Z",
+						"created-at": "2024-01-01T09:00:00Z"
+					}],
+					"next-page-token": "next"
+				}`))
+				return
+			}
+			// nolint - don't need to err check writing the response in the test
+			w.Write([]byte(`{
+				"tabulars": [{
+					"id": "v1",
+					"name": "orders_view",
+					"warehouse-id": "w1",
+					"namespace": ["sales"],
+					"typ": "view",
+					"expiration-date": "2025-01-08T09:00:00Z",
+					"deleted-at": "2025-01-01T09:00:00Z",
+					"created-at": "2024-01-01T09:00:00Z"
+				}]
+			}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer mockLakekeeperServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				//lintignore:AT004 // Explicitly testing a provider configuration
+				Config: fmt.Sprintf(`
+				provider "lakekeeper" {
+					endpoint = "%s"
+					auth_url = "%s/token"
+					client_id = "test-id"
+					client_secret = "test-secret"
+				}
+
+				data "lakekeeper_deleted_tabulars" "foo" {
+					project_id = "p1"
+					warehouse_id = "w1"
+				}
+				`, mockLakekeeperServer.URL, mockLakekeeperServer.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakekeeper_deleted_tabulars.foo", "tabulars.#", "2"),
+					resource.TestCheckResourceAttr("data.lakekeeper_deleted_tabulars.foo", "tabulars.0.id", "t1"),
+					resource.TestCheckResourceAttr("data.lakekeeper_deleted_tabulars.foo", "tabulars.0.type", "table"),
+					resource.TestCheckResourceAttr("data.lakekeeper_deleted_tabulars.foo", "tabulars.0.namespace.0", "sales"),
+					resource.TestCheckResourceAttr("data.lakekeeper_deleted_tabulars.foo", "tabulars.0.expiration_date", "2025-01-08T09:00:00Z"),
+					resource.TestCheckResourceAttr("data.lakekeeper_deleted_tabulars.foo", "tabulars.1.id", "v1"),
+					resource.TestCheckResourceAttr("data.lakekeeper_deleted_tabulars.foo", "tabulars.1.type", "view"),
+				),
+			},
+		},
+	})
+}
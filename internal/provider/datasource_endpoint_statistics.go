@@ -0,0 +1,268 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	managementv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1"
+	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
+	"github.com/baptistegh/go-lakekeeper/pkg/core"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &lakekeeperEndpointStatisticsDataSource{}
+	_ datasource.DataSourceWithConfigure = &lakekeeperEndpointStatisticsDataSource{}
+)
+
+func init() {
+	registerDataSource(NewLakekeeperEndpointStatisticsDataSource)
+}
+
+// NewLakekeeperEndpointStatisticsDataSource is a helper function to simplify the provider implementation.
+func NewLakekeeperEndpointStatisticsDataSource() datasource.DataSource {
+	return &lakekeeperEndpointStatisticsDataSource{}
+}
+
+// lakekeeperEndpointStatisticsDataSource is the data source implementation.
+type lakekeeperEndpointStatisticsDataSource struct {
+	client *lakekeeper.Client
+}
+
+// lakekeeperEndpointStatisticsDataSourceModel describes the data source data model.
+type lakekeeperEndpointStatisticsDataSourceModel struct {
+	ID                types.String                       `tfsdk:"id"`
+	ProjectID         types.String                       `tfsdk:"project_id"`
+	WarehouseID       types.String                       `tfsdk:"warehouse_id"`
+	StatusCodes       []types.Int64                      `tfsdk:"status_codes"`
+	End               types.String                       `tfsdk:"end"`
+	Interval          types.String                       `tfsdk:"interval"`
+	PageToken         types.String                       `tfsdk:"page_token"`
+	Endpoints         []lakekeeperEndpointStatisticModel `tfsdk:"endpoints"`
+	NextPageToken     types.String                       `tfsdk:"next_page_token"`
+	PreviousPageToken types.String                       `tfsdk:"previous_page_token"`
+}
+
+// lakekeeperEndpointStatisticModel describes the calls of an endpoint during a time-slice.
+type lakekeeperEndpointStatisticModel struct {
+	Timestamp     types.String `tfsdk:"timestamp"`
+	HTTPRoute     types.String `tfsdk:"http_route"`
+	StatusCode    types.Int64  `tfsdk:"status_code"`
+	Count         types.Int64  `tfsdk:"count"`
+	WarehouseID   types.String `tfsdk:"warehouse_id"`
+	WarehouseName types.String `tfsdk:"warehouse_name"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *lakekeeperEndpointStatisticsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_endpoint_statistics"
+}
+
+// Schema defines the schema for the data source.
+func (d *lakekeeperEndpointStatisticsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`lakekeeper_endpoint_statistics`" + ` data source retrieves the number of calls of each API endpoint of a project, by status code and by hour.
+
+By default, the statistics of the last day are returned. Use ` + "`end`" + ` and ` + "`interval`" + ` to select another time window, or ` + "`page_token`" + ` to move to a neighboring window.
+
+**Upstream API**: [Lakekeeper REST API docs](https://docs.lakekeeper.io/docs/nightly/api/management/#tag/project/operation/get_endpoint_statistics)`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The internal ID of this data source, the ID of the project.",
+				Computed:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project.",
+				Required:            true,
+			},
+			"warehouse_id": schema.StringAttribute{
+				MarkdownDescription: "Only return the calls handled by this warehouse. All calls are returned if not set.",
+				Optional:            true,
+			},
+			"status_codes": schema.ListAttribute{
+				MarkdownDescription: "Only return the calls answered with one of these status codes.",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+			},
+			"end": schema.StringAttribute{
+				MarkdownDescription: "End of the time window, in RFC3339 format. Default is now.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("page_token")),
+				},
+			},
+			"interval": schema.StringAttribute{
+				MarkdownDescription: "Duration of the time window, as an ISO8601 duration, e.g. `PT1H` for one hour or `P7D` for seven days. Default is `P1D`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("page_token")),
+				},
+			},
+			"page_token": schema.StringAttribute{
+				MarkdownDescription: "Token of the time window to return, from the `next_page_token` or `previous_page_token` of another `lakekeeper_endpoint_statistics` data source.",
+				Optional:            true,
+			},
+			"endpoints": schema.ListNestedAttribute{
+				MarkdownDescription: "Calls of each endpoint, for each time-slice of the window.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"timestamp": schema.StringAttribute{
+							MarkdownDescription: "The time-slice of these statistics.",
+							Computed:            true,
+						},
+						"http_route": schema.StringAttribute{
+							MarkdownDescription: "The route of the endpoint, in the form `METHOD /path/to/endpoint`.",
+							Computed:            true,
+						},
+						"status_code": schema.Int64Attribute{
+							MarkdownDescription: "The status code of the responses.",
+							Computed:            true,
+						},
+						"count": schema.Int64Attribute{
+							MarkdownDescription: "Number of calls during the time-slice.",
+							Computed:            true,
+						},
+						"warehouse_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the warehouse which handled the calls, if any.",
+							Computed:            true,
+						},
+						"warehouse_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the warehouse which handled the calls, if any.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "First call of the endpoint during the time-slice.",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Last update of these statistics.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"next_page_token": schema.StringAttribute{
+				MarkdownDescription: "Token of the next time window.",
+				Computed:            true,
+			},
+			"previous_page_token": schema.StringAttribute{
+				MarkdownDescription: "Token of the previous time window.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *lakekeeperEndpointStatisticsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	datasource := req.ProviderData.(*LakekeeperDatasourceData)
+	d.client = datasource.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *lakekeeperEndpointStatisticsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state lakekeeperEndpointStatisticsDataSourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := state.ProjectID.ValueString()
+
+	opts := managementv1.GetAPIStatisticsOptions{}
+
+	opts.Warehouse.Type = "all"
+	if !state.WarehouseID.IsNull() {
+		opts.Warehouse.Type = "warehouse-id"
+		opts.Warehouse.ID = state.WarehouseID.ValueStringPointer()
+	}
+
+	for _, c := range state.StatusCodes {
+		opts.StatusCodes = append(opts.StatusCodes, int32(c.ValueInt64()))
+	}
+
+	switch {
+	case !state.PageToken.IsNull():
+		opts.RangeSpecifier = &struct {
+			Type     string  `json:"type"`
+			End      *string `json:"end,omitempty"`
+			Interval *string `json:"interval,omitempty"`
+			Token    *string `json:"token,omitempty"`
+		}{
+			Type:  "page-token",
+			Token: state.PageToken.ValueStringPointer(),
+		}
+	case !state.End.IsNull() || !state.Interval.IsNull():
+		end := time.Now().UTC().Format(time.RFC3339)
+		if !state.End.IsNull() {
+			t, err := time.Parse(time.RFC3339, state.End.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("end"), "Invalid date", fmt.Sprintf("must be in RFC3339 format, %v", err))
+				return
+			}
+			end = t.Format(time.RFC3339)
+		}
+
+		opts.RangeSpecifier = &struct {
+			Type     string  `json:"type"`
+			End      *string `json:"end,omitempty"`
+			Interval *string `json:"interval,omitempty"`
+			Token    *string `json:"token,omitempty"`
+		}{
+			Type:     "window",
+			End:      core.Ptr(end),
+			Interval: state.Interval.ValueStringPointer(),
+		}
+	}
+
+	stats, _, err := d.client.ProjectV1().GetAPIStatistics(ctx, projectID, &opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read endpoint statistics for project %s, %v", projectID, err))
+		return
+	}
+
+	state.Endpoints = []lakekeeperEndpointStatisticModel{}
+	for i, endpoints := range stats.CalledEnpoints {
+		timestamp := types.StringNull()
+		if i < len(stats.Timestamps) {
+			timestamp = types.StringValue(stats.Timestamps[i])
+		}
+
+		for _, e := range endpoints {
+			state.Endpoints = append(state.Endpoints, lakekeeperEndpointStatisticModel{
+				Timestamp:     timestamp,
+				HTTPRoute:     types.StringValue(e.HTTPRoute),
+				StatusCode:    types.Int64Value(int64(e.StatusCode)),
+				Count:         types.Int64Value(e.Count),
+				WarehouseID:   types.StringPointerValue(e.WarehouseID),
+				WarehouseName: types.StringPointerValue(e.WarehouseName),
+				CreatedAt:     types.StringValue(e.CreatedAt),
+				UpdatedAt:     types.StringPointerValue(e.UpdatedAt),
+			})
+		}
+	}
+
+	state.ID = types.StringValue(projectID)
+	state.NextPageToken = types.StringValue(stats.NextPageToken)
+	state.PreviousPageToken = types.StringValue(stats.PreviousPageToken)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,116 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/testutil"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDataLakekeeperEndpointStatistics_basic(t *testing.T) {
+	project := testutil.CreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				data "lakekeeper_endpoint_statistics" "foo" {
+					project_id = "%s"
+					interval = "PT1H"
+				}
+				`, project.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakekeeper_endpoint_statistics.foo", "id", project.ID),
+					resource.TestCheckResourceAttrSet("data.lakekeeper_endpoint_statistics.foo", "endpoints.#"),
+					resource.TestCheckResourceAttrSet("data.lakekeeper_endpoint_statistics.foo", "previous_page_token"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataLakekeeperEndpointStatistics_mock(t *testing.T) {
+	var statisticsBody map[string]any
+
+	mockLakekeeperServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/token" && r.Method == "POST":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			// nolint - don't need to err check writing the response in the test
+			w.Write([]byte(`{"access_token": "SlAV32hkKG", "token_type": "Bearer", "expires_in": 3600}`))
+		case r.URL.Path == "/management/v1/endpoint-statistics" && r.Method == "POST":
+			if err := json.NewDecoder(r.Body).Decode(&statisticsBody); err != nil {
+				t.Errorf("could not decode statistics request, %v", err)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			// nolint - don't need to err check writing the response in the test
+			w.Write([]byte(`{
+				"called-endpoints": [[
+					{"count": 3, "created-at": "2025-01-01T10:01:00Z", "http-route": "GET /catalog/v1/{prefix}/namespaces", "status-code": 200, "warehouse-id": "w1", "warehouse-name": "wh"},
+					{"count": 1, "created-at": "2025-01-01T10:05:00Z", "http-route": "POST /management/v1/warehouse", "status-code": 201}
+				]],
+				"next-page-token": "next",
+				"previous-page-token": "previous",
+				"timestamps": ["2025-01-01T11:00:00Z"]
+			}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer mockLakekeeperServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				//lintignore:AT004 // Explicitly testing a provider configuration
+				Config: fmt.Sprintf(`
+				provider "lakekeeper" {
+					endpoint = "%s"
+					auth_url = "%s/token"
+					client_id = "test-id"
+					client_secret = "test-secret"
+				}
+
+				data "lakekeeper_endpoint_statistics" "foo" {
+					project_id = "p1"
+					warehouse_id = "w1"
+					status_codes = [200]
+					end = "2025-01-02T00:00:00Z"
+					interval = "P1D"
+				}
+				`, mockLakekeeperServer.URL, mockLakekeeperServer.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakekeeper_endpoint_statistics.foo", "endpoints.#", "2"),
+					resource.TestCheckResourceAttr("data.lakekeeper_endpoint_statistics.foo", "endpoints.0.timestamp", "2025-01-01T11:00:00Z"),
+					resource.TestCheckResourceAttr("data.lakekeeper_endpoint_statistics.foo", "endpoints.0.count", "3"),
+					resource.TestCheckResourceAttr("data.lakekeeper_endpoint_statistics.foo", "endpoints.0.warehouse_name", "wh"),
+					resource.TestCheckNoResourceAttr("data.lakekeeper_endpoint_statistics.foo", "endpoints.1.warehouse_id"),
+					resource.TestCheckResourceAttr("data.lakekeeper_endpoint_statistics.foo", "next_page_token", "next"),
+					resource.TestCheckResourceAttr("data.lakekeeper_endpoint_statistics.foo", "previous_page_token", "previous"),
+					func(*terraform.State) error {
+						rangeSpecifier, _ := statisticsBody["range-specifier"].(map[string]any)
+						warehouse, _ := statisticsBody["warehouse"].(map[string]any)
+						if rangeSpecifier["type"] != "window" || rangeSpecifier["end"] != "2025-01-02T00:00:00Z" || rangeSpecifier["interval"] != "P1D" {
+							return fmt.Errorf("unexpected range specifier, got %v", statisticsBody)
+						}
+						if warehouse["type"] != "warehouse-id" || warehouse["id"] != "w1" {
+							return fmt.Errorf("unexpected warehouse filter, got %v", statisticsBody)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 	"fmt"
 
+	managementv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1"
 	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -50,11 +54,16 @@ func (d *LakekeeperProjectDataSource) Schema(_ context.Context, _ datasource.Sch
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the project.",
-				Required:            true,
+				MarkdownDescription: "The ID of the project. Exactly one of `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the project.",
+				MarkdownDescription: "The name of the project. The project is looked up by name among the projects visible to the provider when `id` is not set.",
+				Optional:            true,
 				Computed:            true,
 			},
 		},
@@ -82,11 +91,15 @@ func (d *LakekeeperProjectDataSource) Read(ctx context.Context, req datasource.R
 		return
 	}
 
-	id := state.ID.ValueString()
-
-	project, _, err := d.client.ProjectV1().Get(ctx, id)
+	var project *managementv1.Project
+	var err error
+	if !state.ID.IsNull() {
+		project, _, err = d.client.ProjectV1().Get(ctx, state.ID.ValueString())
+	} else {
+		project, err = findProjectByName(ctx, d.client, state.Name.ValueString())
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read project %s, %v", id, err))
+		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read project, %v", err))
 		return
 	}
 
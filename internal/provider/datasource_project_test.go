@@ -4,6 +4,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/testutil"
@@ -31,3 +32,33 @@ func TestAccDataLakekeeperProject_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDataLakekeeperProject_byName(t *testing.T) {
+
+	project := testutil.CreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "lakekeeper_project" "foo" {
+				  name = "does-not-exist"
+				}
+				`,
+				ExpectError: regexp.MustCompile(`found no\s+project\s+named\s+"does-not-exist"`),
+			},
+			{
+				Config: fmt.Sprintf(`
+				data "lakekeeper_project" "foo" {
+				  name = "%s"
+				}
+				`, project.Name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakekeeper_project.foo", "id", project.ID),
+					resource.TestCheckResourceAttr("data.lakekeeper_project.foo", "name", project.Name),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &lakekeeperProjectsDataSource{}
+	_ datasource.DataSourceWithConfigure = &lakekeeperProjectsDataSource{}
+)
+
+func init() {
+	registerDataSource(NewLakekeeperProjectsDataSource)
+}
+
+// NewLakekeeperProjectsDataSource is a helper function to simplify the provider implementation.
+func NewLakekeeperProjectsDataSource() datasource.DataSource {
+	return &lakekeeperProjectsDataSource{}
+}
+
+// lakekeeperProjectsDataSource is the data source implementation.
+type lakekeeperProjectsDataSource struct {
+	client *lakekeeper.Client
+}
+
+// lakekeeperProjectsDataSourceModel describes the data source data model.
+type lakekeeperProjectsDataSourceModel struct {
+	Projects []LakekeeperProjectDataSourceModel `tfsdk:"projects"`
+}
+
+// Metadata returns the data source type name.
+func (d *lakekeeperProjectsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_projects"
+}
+
+// Schema defines the schema for the data source.
+func (d *lakekeeperProjectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`lakekeeper_projects`" + ` data source lists the projects visible to the provider.
+
+**Upstream API**: [Lakekeeper REST API docs](https://docs.lakekeeper.io/docs/nightly/api/management/#tag/project/operation/list_projects)`,
+
+		Attributes: map[string]schema.Attribute{
+			"projects": schema.ListNestedAttribute{
+				MarkdownDescription: "List of projects.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the project.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the project.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *lakekeeperProjectsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	datasource := req.ProviderData.(*LakekeeperDatasourceData)
+	d.client = datasource.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *lakekeeperProjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state lakekeeperProjectsDataSourceModel
+
+	projects, _, err := d.client.ProjectV1().List(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to list projects, %v", err))
+		return
+	}
+
+	state.Projects = []LakekeeperProjectDataSourceModel{}
+	for _, p := range projects.Projects {
+		state.Projects = append(state.Projects, LakekeeperProjectDataSourceModel{
+			ID:   types.StringValue(p.ID),
+			Name: types.StringValue(p.Name),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
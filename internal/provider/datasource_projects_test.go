@@ -0,0 +1,34 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/testutil"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataLakekeeperProjects_basic(t *testing.T) {
+
+	project := testutil.CreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "lakekeeper_projects" "all" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.lakekeeper_projects.all", "projects.#"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.lakekeeper_projects.all", "projects.*", map[string]string{
+						"id":   project.ID,
+						"name": project.Name,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.lakekeeper_projects.all", "projects.*", map[string]string{
+						"id": "00000000-0000-0000-0000-000000000000",
+					}),
+				),
+			},
+		},
+	})
+}
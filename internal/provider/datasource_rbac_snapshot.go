@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &lakekeeperRBACSnapshotDataSource{}
+	_ datasource.DataSourceWithConfigure = &lakekeeperRBACSnapshotDataSource{}
+)
+
+func init() {
+	registerDataSource(NewLakekeeperRBACSnapshotDataSource)
+}
+
+// NewLakekeeperRBACSnapshotDataSource is a helper function to simplify the provider implementation.
+func NewLakekeeperRBACSnapshotDataSource() datasource.DataSource {
+	return &lakekeeperRBACSnapshotDataSource{}
+}
+
+// lakekeeperRBACSnapshotDataSource is the data source implementation.
+type lakekeeperRBACSnapshotDataSource struct {
+	client *lakekeeper.Client
+}
+
+// lakekeeperRBACSnapshotDataSourceModel describes the data source data model.
+type lakekeeperRBACSnapshotDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	JSON      types.String `tfsdk:"json"`
+}
+
+// Metadata returns the data source type name.
+func (d *lakekeeperRBACSnapshotDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rbac_snapshot"
+}
+
+// Schema defines the schema for the data source.
+func (d *lakekeeperRBACSnapshotDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`lakekeeper_rbac_snapshot`" + ` data source serializes the roles, the role memberships and the project and warehouse assignments of a project into a JSON document.
+
+The roles and the warehouses are referenced by their name and the users by their ID, so that the document can be applied to a project of another Lakekeeper with the ` + "`lakekeeper_rbac_apply`" + ` resource. The users referenced by the assignments are part of the document.
+
+The document has the following schema, its ` + "`version`" + ` only changes on breaking changes:
+
+` + "```json" + `
+{
+  "version": 1,
+  "roles": [{ "name": "engineers", "description": "Data engineers" }],
+  "users": [{ "id": "oidc~1234", "name": "Anna", "email": "anna@example.com", "user_type": "human" }],
+  "role_assignments": [{ "role": "engineers", "assignee": { "type": "user", "id": "oidc~1234" }, "assignment": "assignee" }],
+  "project_assignments": [{ "assignee": { "type": "role", "name": "engineers" }, "assignment": "describe" }],
+  "warehouse_assignments": [{ "warehouse": "sales", "assignee": { "type": "role", "name": "engineers" }, "assignment": "select" }]
+}
+` + "```",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this data source, the project ID.",
+				Computed:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project.",
+				Required:            true,
+			},
+			"json": schema.StringAttribute{
+				MarkdownDescription: "The RBAC snapshot of the project, as a JSON document. The entries are sorted, the document only changes when the RBAC of the project changes.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *lakekeeperRBACSnapshotDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	datasource := req.ProviderData.(*LakekeeperDatasourceData)
+	d.client = datasource.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *lakekeeperRBACSnapshotDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state lakekeeperRBACSnapshotDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := state.ProjectID.ValueString()
+
+	snapshot, err := readRBACSnapshot(ctx, d.client, projectID)
+	if err != nil {
+		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read the RBAC snapshot of project %s, %v", projectID, err))
+		return
+	}
+
+	document, err := snapshot.JSON()
+	if err != nil {
+		resp.Diagnostics.AddError("Error encoding the RBAC snapshot", err.Error())
+		return
+	}
+
+	state.ID = types.StringValue(projectID)
+	state.JSON = types.StringValue(document)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
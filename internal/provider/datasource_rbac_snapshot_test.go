@@ -0,0 +1,77 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	permissionv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1/permission"
+	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/testutil"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataLakekeeperRBACSnapshot_basic(t *testing.T) {
+
+	project := testutil.CreateProject(t)
+	role := testutil.CreateRole(t, project.ID)
+	user := testutil.CreateUser(t, fmt.Sprintf("oidc~%s", acctest.RandString(8)))
+
+	if _, err := testutil.TestLakekeeperClient.PermissionV1().RolePermission().Update(t.Context(), role.ID, &permissionv1.UpdateRolePermissionsOptions{
+		Writes: []*permissionv1.RoleAssignment{
+			{Assignee: permissionv1.UserOrRole{Type: permissionv1.UserType, Value: user.ID}, Assignment: permissionv1.AssigneeRoleAssignment},
+		},
+	}); err != nil {
+		t.Fatalf("could not assign the role: %v", err)
+	}
+
+	if _, err := testutil.TestLakekeeperClient.PermissionV1().ProjectPermission().Update(t.Context(), project.ID, &permissionv1.UpdateProjectPermissionsOptions{
+		Writes: []*permissionv1.ProjectAssignment{
+			{Assignee: permissionv1.UserOrRole{Type: permissionv1.RoleType, Value: role.ID}, Assignment: permissionv1.DescribeProjectAssignment},
+		},
+	}); err != nil {
+		t.Fatalf("could not assign the project: %v", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				data "lakekeeper_rbac_snapshot" "test" {
+					project_id = "%s"
+				}`, project.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakekeeper_rbac_snapshot.test", "id", project.ID),
+					resource.TestCheckResourceAttrWith("data.lakekeeper_rbac_snapshot.test", "json", func(value string) error {
+						snapshot, err := parseRBACSnapshot(value)
+						if err != nil {
+							return err
+						}
+
+						// the IDs of the roles are replaced by their name
+						if strings.Contains(value, role.ID) {
+							return fmt.Errorf("the snapshot contains the ID of role %s", role.Name)
+						}
+
+						if !isSubset([]rbacRole{{Name: role.Name, Description: *role.Description}}, snapshot.Roles) {
+							return fmt.Errorf("role %s not found in %s", role.Name, value)
+						}
+						if !isSubset([]rbacRoleAssignment{{Role: role.Name, rbacAssignment: rbacAssignment{Assignee: rbacAssignee{Type: "user", ID: user.ID}, Assignment: "assignee"}}}, snapshot.RoleAssignments) {
+							return fmt.Errorf("membership of user %s not found in %s", user.ID, value)
+						}
+						if !isSubset([]rbacAssignment{{Assignee: rbacAssignee{Type: "role", Name: role.Name}, Assignment: "describe"}}, snapshot.ProjectAssignments) {
+							return fmt.Errorf("project assignment of role %s not found in %s", role.Name, value)
+						}
+						if !strings.Contains(value, fmt.Sprintf("%q", user.Name)) {
+							return fmt.Errorf("user %s not found in %s", user.ID, value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 	"fmt"
 
+	managementv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1"
 	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -60,15 +64,20 @@ func (d *LakekeeperRoleDataSource) Schema(_ context.Context, _ datasource.Schema
 				Computed:            true,
 			},
 			"role_id": schema.StringAttribute{
-				MarkdownDescription: `The internal ID of the role.`,
-				Required:            true,
+				MarkdownDescription: "The internal ID of the role. Exactly one of `role_id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
 			},
 			"project_id": schema.StringAttribute{
 				MarkdownDescription: `The ID of the project the role belongs to.`,
 				Required:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the role.",
+				MarkdownDescription: "The name of the role. The role is looked up by name in the project when `role_id` is not set.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"description": schema.StringAttribute{
@@ -108,12 +117,17 @@ func (d *LakekeeperRoleDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	id := state.RoleID.ValueString()
 	projectID := state.ProjectID.ValueString()
 
-	role, _, err := d.client.RoleV1(projectID).Get(ctx, id)
+	var role *managementv1.Role
+	var err error
+	if !state.RoleID.IsNull() {
+		role, _, err = d.client.RoleV1(projectID).Get(ctx, state.RoleID.ValueString())
+	} else {
+		role, err = findRoleByName(ctx, d.client, projectID, state.Name.ValueString())
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read role %s in project %s, %v", id, projectID, err))
+		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read role in project %s, %v", projectID, err))
 		return
 	}
 
@@ -4,6 +4,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/testutil"
@@ -57,3 +58,36 @@ func TestAccDataLakekeeperRole_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDataLakekeeperRole_byName(t *testing.T) {
+
+	project := testutil.CreateProject(t)
+	role := testutil.CreateRole(t, project.ID)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				data "lakekeeper_role" "foo" {
+					name = "%s"
+					role_id = "%s"
+					project_id = "%s"
+				}`, role.Name, role.ID, project.ID),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config: fmt.Sprintf(`
+				data "lakekeeper_role" "foo" {
+					name = "%s"
+					project_id = "%s"
+				}`, role.Name, project.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakekeeper_role.foo", "id", fmt.Sprintf("%s/%s", project.ID, role.ID)),
+					resource.TestCheckResourceAttr("data.lakekeeper_role.foo", "role_id", role.ID),
+					resource.TestCheckResourceAttr("data.lakekeeper_role.foo", "name", role.Name),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	managementv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1"
+	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &lakekeeperRolesDataSource{}
+	_ datasource.DataSourceWithConfigure = &lakekeeperRolesDataSource{}
+)
+
+func init() {
+	registerDataSource(NewLakekeeperRolesDataSource)
+}
+
+// NewLakekeeperRolesDataSource is a helper function to simplify the provider implementation.
+func NewLakekeeperRolesDataSource() datasource.DataSource {
+	return &lakekeeperRolesDataSource{}
+}
+
+// lakekeeperRolesDataSource is the data source implementation.
+type lakekeeperRolesDataSource struct {
+	client *lakekeeper.Client
+}
+
+// lakekeeperRolesDataSourceModel describes the data source data model.
+type lakekeeperRolesDataSourceModel struct {
+	ID        types.String                    `tfsdk:"id"`
+	ProjectID types.String                    `tfsdk:"project_id"`
+	Search    types.String                    `tfsdk:"search"`
+	Roles     []LakekeeperRoleDataSourceModel `tfsdk:"roles"`
+}
+
+// Metadata returns the data source type name.
+func (d *lakekeeperRolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_roles"
+}
+
+// Schema defines the schema for the data source.
+func (d *lakekeeperRolesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`lakekeeper_roles`" + ` data source lists the roles of a project.
+
+**Upstream API**: [Lakekeeper REST API docs](https://docs.lakekeeper.io/docs/nightly/api/management/#tag/role/operation/list_roles)`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this data source, the project ID.",
+				Computed:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project.",
+				Required:            true,
+			},
+			"search": schema.StringAttribute{
+				MarkdownDescription: "Only list the roles matching this search string, by fuzzy matching of the name. The roles are sorted by relevance.",
+				Optional:            true,
+			},
+			"roles": schema.ListNestedAttribute{
+				MarkdownDescription: "List of roles.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the role. in the form `{{project_id}}/{{role_id}}`",
+							Computed:            true,
+						},
+						"role_id": schema.StringAttribute{
+							MarkdownDescription: `The internal ID of the role.`,
+							Computed:            true,
+						},
+						"project_id": schema.StringAttribute{
+							MarkdownDescription: `The ID of the project the role belongs to.`,
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the role.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the role.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the role has been created.",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "When the role has last been modified.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *lakekeeperRolesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	datasource := req.ProviderData.(*LakekeeperDatasourceData)
+	d.client = datasource.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *lakekeeperRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state lakekeeperRolesDataSourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := state.ProjectID.ValueString()
+
+	var roles []*managementv1.Role
+	if state.Search.IsNull() {
+		var err error
+		roles, err = listRoles(ctx, d.client, projectID, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to list roles in project %s, %v", projectID, err))
+			return
+		}
+	} else {
+		result, _, err := d.client.RoleV1(projectID).Search(ctx, &managementv1.SearchRoleOptions{
+			Search: state.Search.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to search roles in project %s, %v", projectID, err))
+			return
+		}
+		roles = result.Roles
+	}
+
+	state.Roles = []LakekeeperRoleDataSourceModel{}
+	for _, r := range roles {
+		state.Roles = append(state.Roles, LakekeeperRoleDataSourceModel{
+			ID:          types.StringValue(fmt.Sprintf("%s/%s", r.ProjectID, r.ID)),
+			RoleID:      types.StringValue(r.ID),
+			ProjectID:   types.StringValue(r.ProjectID),
+			Name:        types.StringValue(r.Name),
+			Description: types.StringPointerValue(r.Description),
+			CreatedAt:   types.StringValue(r.CreatedAt),
+			UpdatedAt:   types.StringPointerValue(r.UpdatedAt),
+		})
+	}
+
+	state.ID = types.StringValue(projectID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
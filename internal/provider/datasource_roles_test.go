@@ -0,0 +1,53 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/testutil"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataLakekeeperRoles_basic(t *testing.T) {
+
+	project := testutil.CreateProject(t)
+	role1 := testutil.CreateRole(t, project.ID)
+	role2 := testutil.CreateRole(t, project.ID)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				data "lakekeeper_roles" "all" {
+					project_id = "%s"
+				}
+				data "lakekeeper_roles" "search" {
+					project_id = "%s"
+					search = "%s"
+				}`, project.ID, project.ID, role2.Name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakekeeper_roles.all", "id", project.ID),
+					resource.TestCheckResourceAttr("data.lakekeeper_roles.all", "roles.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.lakekeeper_roles.all", "roles.*", map[string]string{
+						"id":          fmt.Sprintf("%s/%s", project.ID, role1.ID),
+						"role_id":     role1.ID,
+						"project_id":  project.ID,
+						"name":        role1.Name,
+						"description": *role1.Description,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.lakekeeper_roles.all", "roles.*", map[string]string{
+						"role_id": role2.ID,
+						"name":    role2.Name,
+					}),
+
+					// the best match comes first
+					resource.TestCheckResourceAttr("data.lakekeeper_roles.search", "roles.0.role_id", role2.ID),
+					resource.TestCheckResourceAttr("data.lakekeeper_roles.search", "roles.0.name", role2.Name),
+				),
+			},
+		},
+	})
+}
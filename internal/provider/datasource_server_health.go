@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
+	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &lakekeeperServerHealthDataSource{}
+	_ datasource.DataSourceWithConfigure = &lakekeeperServerHealthDataSource{}
+)
+
+func init() {
+	registerDataSource(NewLakekeeperServerHealthDataSource)
+}
+
+// NewLakekeeperServerHealthDataSource is a helper function to simplify the provider implementation.
+func NewLakekeeperServerHealthDataSource() datasource.DataSource {
+	return &lakekeeperServerHealthDataSource{}
+}
+
+// lakekeeperServerHealthDataSource is the data source implementation.
+type lakekeeperServerHealthDataSource struct {
+	client     *lakekeeper.Client
+	httpClient *http.Client
+}
+
+// lakekeeperServerHealthDataSourceModel describes the data source data model.
+type lakekeeperServerHealthDataSourceModel struct {
+	Healthy        types.Bool                     `tfsdk:"healthy"`
+	Status         types.String                   `tfsdk:"status"`
+	HTTPStatusCode types.Int64                    `tfsdk:"http_status_code"`
+	Services       []lakekeeperServiceHealthModel `tfsdk:"services"`
+}
+
+// lakekeeperServiceHealthModel describes the health of a service used by the server.
+type lakekeeperServiceHealthModel struct {
+	Service   types.String `tfsdk:"service"`
+	Name      types.String `tfsdk:"name"`
+	Status    types.String `tfsdk:"status"`
+	CheckedAt types.String `tfsdk:"checked_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *lakekeeperServerHealthDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_health"
+}
+
+// Schema defines the schema for the data source.
+func (d *lakekeeperServerHealthDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`lakekeeper_server_health`" + ` data source retrieves the health of a lakekeeper instance and of the services it depends on.
+
+An unhealthy server does not fail the read, check the ` + "`healthy`" + ` attribute instead.`,
+
+		Attributes: map[string]schema.Attribute{
+			"healthy": schema.BoolAttribute{
+				MarkdownDescription: "True if the server reports itself as healthy",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The overall health status reported by the server, e.g. `ok`",
+				Computed:            true,
+			},
+			"http_status_code": schema.Int64Attribute{
+				MarkdownDescription: "The HTTP status code returned by the health endpoint",
+				Computed:            true,
+			},
+			"services": schema.ListNestedAttribute{
+				MarkdownDescription: "The health of the services used by the server",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"service": schema.StringAttribute{
+							MarkdownDescription: "The kind of service, e.g. `catalog` or `secrets`",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the service",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The health status of the service",
+							Computed:            true,
+						},
+						"checked_at": schema.StringAttribute{
+							MarkdownDescription: "The last time the health of the service was checked",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *lakekeeperServerHealthDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	datasource := req.ProviderData.(*LakekeeperDatasourceData)
+	d.client = datasource.Client
+	d.httpClient = datasource.HTTPClient
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *lakekeeperServerHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state lakekeeperServerHealthDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	health, err := api.GetServerHealth(ctx, d.httpClient, d.client.BaseURL())
+	if err != nil {
+		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read server health, %v", err))
+		return
+	}
+
+	state.Healthy = types.BoolValue(health.IsHealthy())
+	state.Status = types.StringValue(health.Health)
+	state.HTTPStatusCode = types.Int64Value(int64(health.StatusCode))
+	state.Services = flattenServicesHealth(health.Services)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func flattenServicesHealth(services map[string][]api.ServiceHealth) []lakekeeperServiceHealthModel {
+	kinds := make([]string, 0, len(services))
+	for kind := range services {
+		kinds = append(kinds, kind)
+	}
+	// keep a stable order between reads
+	sort.Strings(kinds)
+
+	result := []lakekeeperServiceHealthModel{}
+	for _, kind := range kinds {
+		for _, s := range services[kind] {
+			result = append(result, lakekeeperServiceHealthModel{
+				Service:   types.StringValue(kind),
+				Name:      types.StringValue(s.Name),
+				Status:    types.StringValue(s.Status),
+				CheckedAt: types.StringValue(s.CheckedAt),
+			})
+		}
+	}
+	return result
+}
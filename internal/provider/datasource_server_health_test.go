@@ -0,0 +1,26 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataLakekeeperServerHealth_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "lakekeeper_server_health" "foo" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakekeeper_server_health.foo", "healthy", "true"),
+					resource.TestCheckResourceAttr("data.lakekeeper_server_health.foo", "status", "ok"),
+					resource.TestCheckResourceAttr("data.lakekeeper_server_health.foo", "http_status_code", "200"),
+					resource.TestCheckResourceAttrSet("data.lakekeeper_server_health.foo", "services.#"),
+				),
+			},
+		},
+	})
+}
@@ -7,8 +7,11 @@ import (
 	managementv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1"
 	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -57,15 +60,21 @@ func (d *LakekeeperUserDataSource) Schema(_ context.Context, _ datasource.Schema
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: `The ID of the user. The id must be identical to the subject in JWT tokens, prefixed with` + "`<idp-identifier>~`" + `. For example: ` + "`oidc~1234567890`" + ` for OIDC users or kubernetes~1234567890 for Kubernetes users.`,
-				Required:            true,
+				MarkdownDescription: `The ID of the user. The id must be identical to the subject in JWT tokens, prefixed with` + "`<idp-identifier>~`" + `. For example: ` + "`oidc~1234567890`" + ` for OIDC users or kubernetes~1234567890 for Kubernetes users. Exactly one of ` + "`id`, `name` or `email`" + ` must be set.`,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name"), path.MatchRoot("email")),
+				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the user.",
+				MarkdownDescription: "The name of the user. The user is looked up by name when set.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"email": schema.StringAttribute{
-				MarkdownDescription: "The email of the user.",
+				MarkdownDescription: "The email of the user. The user is looked up by email when set, the comparison is case insensitive.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"user_type": schema.StringAttribute{
@@ -109,9 +118,18 @@ func (d *LakekeeperUserDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	user, _, err := d.client.UserV1().Get(ctx, state.ID.ValueString())
+	var user *managementv1.User
+	var err error
+	switch {
+	case !state.ID.IsNull():
+		user, _, err = d.client.UserV1().Get(ctx, state.ID.ValueString())
+	case !state.Name.IsNull():
+		user, err = findUserByName(ctx, d.client, state.Name.ValueString())
+	default:
+		user, err = findUserByEmail(ctx, d.client, state.Email.ValueString())
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read user %s, %v", state.ID.ValueString(), err))
+		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read user, %v", err))
 		return
 	}
 
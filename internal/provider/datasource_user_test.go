@@ -4,6 +4,7 @@ package provider
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/testutil"
@@ -35,3 +36,29 @@ func TestAccDataLakekeeperUser_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDataLakekeeperUser_byNameOrEmail(t *testing.T) {
+	rID := fmt.Sprintf("oidc~%s", uuid.New().String())
+	user := testutil.CreateUser(t, rID)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				data "lakekeeper_user" "by_name" {
+					name = "%s"
+				}
+				data "lakekeeper_user" "by_email" {
+					email = "%s"
+				}`, user.Name, strings.ToUpper(*user.Email)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakekeeper_user.by_name", "id", rID),
+					resource.TestCheckResourceAttr("data.lakekeeper_user.by_name", "email", *user.Email),
+					resource.TestCheckResourceAttr("data.lakekeeper_user.by_email", "id", rID),
+					resource.TestCheckResourceAttr("data.lakekeeper_user.by_email", "name", user.Name),
+				),
+			},
+		},
+	})
+}
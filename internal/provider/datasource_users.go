@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	managementv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1"
+	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &lakekeeperUsersDataSource{}
+	_ datasource.DataSourceWithConfigure = &lakekeeperUsersDataSource{}
+)
+
+func init() {
+	registerDataSource(NewLakekeeperUsersDataSource)
+}
+
+// NewLakekeeperUsersDataSource is a helper function to simplify the provider implementation.
+func NewLakekeeperUsersDataSource() datasource.DataSource {
+	return &lakekeeperUsersDataSource{}
+}
+
+// lakekeeperUsersDataSource is the data source implementation.
+type lakekeeperUsersDataSource struct {
+	client *lakekeeper.Client
+}
+
+// lakekeeperUsersDataSourceModel describes the data source data model.
+type lakekeeperUsersDataSourceModel struct {
+	Name  types.String                    `tfsdk:"name"`
+	Email types.String                    `tfsdk:"email"`
+	Users []LakekeeperUserDataSourceModel `tfsdk:"users"`
+}
+
+// Metadata returns the data source type name.
+func (d *lakekeeperUsersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+// Schema defines the schema for the data source.
+func (d *lakekeeperUsersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`lakekeeper_users`" + ` data source lists the users known to Lakekeeper. All the pages of the list are fetched.
+
+**Upstream API**: [Lakekeeper REST API docs](https://docs.lakekeeper.io/docs/nightly/api/management/#tag/user/operation/list_user)`,
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Only list the users whose name contains this string.",
+				Optional:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Only list the users whose email contains this string, the comparison is case insensitive.",
+				Optional:            true,
+			},
+			"users": schema.ListNestedAttribute{
+				MarkdownDescription: "List of users.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the user.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the user.",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The email of the user.",
+							Computed:            true,
+						},
+						"user_type": schema.StringAttribute{
+							MarkdownDescription: fmt.Sprintf("The type of the user (`%s` or `%s`)", managementv1.HumanUserType, managementv1.ApplicationUserType),
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the user has been created.",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "When the user has last been modified.",
+							Computed:            true,
+						},
+						"last_updated_with": schema.StringAttribute{
+							MarkdownDescription: "The endpoint who last modified the user.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *lakekeeperUsersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	datasource := req.ProviderData.(*LakekeeperDatasourceData)
+	d.client = datasource.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *lakekeeperUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state lakekeeperUsersDataSourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := listUsers(ctx, d.client, &managementv1.ListUsersOptions{
+		Name: state.Name.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to list users, %v", err))
+		return
+	}
+
+	// the API cannot filter on emails
+	email := strings.ToLower(state.Email.ValueString())
+
+	state.Users = []LakekeeperUserDataSourceModel{}
+	for _, u := range users {
+		if email != "" && (u.Email == nil || !strings.Contains(strings.ToLower(*u.Email), email)) {
+			continue
+		}
+
+		state.Users = append(state.Users, LakekeeperUserDataSourceModel{
+			ID:              types.StringValue(u.ID),
+			Name:            types.StringValue(u.Name),
+			Email:           types.StringPointerValue(u.Email),
+			UserType:        types.StringValue(string(u.UserType)),
+			CreatedAt:       types.StringValue(u.CreatedAt),
+			UpdatedAt:       types.StringPointerValue(u.UpdatedAt),
+			LastUpdatedWith: types.StringValue(u.LastUpdatedWith),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
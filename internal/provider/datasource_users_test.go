@@ -0,0 +1,54 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/testutil"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataLakekeeperUsers_basic(t *testing.T) {
+
+	user := testutil.CreateUser(t, fmt.Sprintf("oidc~%s", acctest.RandString(8)))
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				data "lakekeeper_users" "all" {}
+				data "lakekeeper_users" "by_name" {
+					name = "%s"
+				}
+				data "lakekeeper_users" "by_email" {
+					email = "%s"
+				}
+				data "lakekeeper_users" "none" {
+					email = "does-not-exist@%s"
+				}`, user.Name, strings.ToUpper(*user.Email), acctest.RandString(8)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.lakekeeper_users.all", "users.*", map[string]string{
+						"id":    user.ID,
+						"name":  user.Name,
+						"email": *user.Email,
+					}),
+
+					resource.TestCheckResourceAttr("data.lakekeeper_users.by_name", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.lakekeeper_users.by_name", "users.0.id", user.ID),
+					resource.TestCheckResourceAttr("data.lakekeeper_users.by_name", "users.0.user_type", string(user.UserType)),
+					resource.TestCheckResourceAttr("data.lakekeeper_users.by_name", "users.0.created_at", user.CreatedAt),
+
+					resource.TestCheckResourceAttr("data.lakekeeper_users.by_email", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.lakekeeper_users.by_email", "users.0.id", user.ID),
+
+					resource.TestCheckResourceAttr("data.lakekeeper_users.none", "users.#", "0"),
+				),
+			},
+		},
+	})
+}
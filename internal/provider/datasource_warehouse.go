@@ -4,11 +4,15 @@ import (
 	"context"
 	"fmt"
 
+	managementv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1"
 	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
 	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/sdk"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -69,15 +73,20 @@ func (d *LakekeeperWarehouseDataSource) Schema(_ context.Context, _ datasource.S
 				Computed:            true,
 			},
 			"warehouse_id": schema.StringAttribute{
-				MarkdownDescription: "The ID the warehouse.",
-				Required:            true,
+				MarkdownDescription: "The ID the warehouse. Exactly one of `warehouse_id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
 			},
 			"project_id": schema.StringAttribute{
 				MarkdownDescription: "The project ID to which the warehouse belongs. If not provided, the default project will be used.",
 				Required:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the warehouse.",
+				MarkdownDescription: "Name of the warehouse. The warehouse is looked up by name in the project when `warehouse_id` is not set.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"protected": schema.BoolAttribute{
@@ -232,21 +241,26 @@ func (d *LakekeeperWarehouseDataSource) Read(ctx context.Context, req datasource
 		return
 	}
 
-	warehouseID := state.WarehouseID.ValueString()
 	projectID := state.ProjectID.ValueString()
 
-	state.ID = types.StringValue(fmt.Sprintf("%s/%s", projectID, warehouseID))
-
-	warehouse, _, err := d.client.WarehouseV1(projectID).Get(ctx, warehouseID)
+	var warehouse *managementv1.Warehouse
+	var err error
+	if !state.WarehouseID.IsNull() {
+		warehouse, _, err = d.client.WarehouseV1(projectID).Get(ctx, state.WarehouseID.ValueString())
+	} else {
+		warehouse, err = findWarehouseByName(ctx, d.client, projectID, state.Name.ValueString())
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read warehouse %s, %v", state.Name.ValueString(), err))
+		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read warehouse in project %s, %v", projectID, err))
 		return
 	}
 
+	warehouseID := warehouse.ID
+
 	// Authorization Properties
 	m, _, err := d.client.PermissionV1().WarehousePermission().GetAuthzProperties(ctx, warehouseID)
 	if err != nil {
-		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read authorization properties for warehouse %s, %v", state.Name.ValueString(), err))
+		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read authorization properties for warehouse %s, %v", state.Name.ValueString(), capabilityError(ctx, d.client, capabilityManagedAccess, err)))
 		return
 	}
 	state.ManagedAccess = types.BoolValue(m.ManagedAccess)
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	managementv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1"
+	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &lakekeeperWarehouseStatisticsDataSource{}
+	_ datasource.DataSourceWithConfigure = &lakekeeperWarehouseStatisticsDataSource{}
+)
+
+func init() {
+	registerDataSource(NewLakekeeperWarehouseStatisticsDataSource)
+}
+
+// NewLakekeeperWarehouseStatisticsDataSource is a helper function to simplify the provider implementation.
+func NewLakekeeperWarehouseStatisticsDataSource() datasource.DataSource {
+	return &lakekeeperWarehouseStatisticsDataSource{}
+}
+
+// lakekeeperWarehouseStatisticsDataSource is the data source implementation.
+type lakekeeperWarehouseStatisticsDataSource struct {
+	client *lakekeeper.Client
+}
+
+// lakekeeperWarehouseStatisticsDataSourceModel describes the data source data model.
+type lakekeeperWarehouseStatisticsDataSourceModel struct {
+	ID          types.String                         `tfsdk:"id"` // form: project_id/warehouse_id (internal ID)
+	ProjectID   types.String                         `tfsdk:"project_id"`
+	WarehouseID types.String                         `tfsdk:"warehouse_id"`
+	From        types.String                         `tfsdk:"from"`
+	To          types.String                         `tfsdk:"to"`
+	Stats       []lakekeeperWarehouseStatisticsModel `tfsdk:"stats"`
+}
+
+// lakekeeperWarehouseStatisticsModel describes the statistics of a warehouse at a point in time.
+type lakekeeperWarehouseStatisticsModel struct {
+	Timestamp      types.String `tfsdk:"timestamp"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+	NumberOfTables types.Int64  `tfsdk:"number_of_tables"`
+	NumberOfViews  types.Int64  `tfsdk:"number_of_views"`
+}
+
+// Metadata returns the data source type name.
+func (d *lakekeeperWarehouseStatisticsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_warehouse_statistics"
+}
+
+// Schema defines the schema for the data source.
+func (d *lakekeeperWarehouseStatisticsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`lakekeeper_warehouse_statistics`" + ` data source retrieves the number of tables and views of a warehouse over time.
+
+Lakekeeper creates a new statistics entry every hour when the warehouse changed.
+
+**Upstream API**: [Lakekeeper REST API docs](https://docs.lakekeeper.io/docs/nightly/api/management/#tag/warehouse/operation/get_warehouse_statistics)`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The internal ID of this data source. In the form: {{project_id}}/{{warehouse_id}}",
+				Computed:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The project ID to which the warehouse belongs.",
+				Required:            true,
+			},
+			"warehouse_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the warehouse.",
+				Required:            true,
+			},
+			"from": schema.StringAttribute{
+				MarkdownDescription: "Only return the statistics valid after this date, in RFC3339 format.",
+				Optional:            true,
+			},
+			"to": schema.StringAttribute{
+				MarkdownDescription: "Only return the statistics valid before this date, in RFC3339 format.",
+				Optional:            true,
+			},
+			"stats": schema.ListNestedAttribute{
+				MarkdownDescription: "Ordered list of statistics.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"timestamp": schema.StringAttribute{
+							MarkdownDescription: "Timestamp until which these statistics are valid.",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Timestamp of the last update of these statistics.",
+							Computed:            true,
+						},
+						"number_of_tables": schema.Int64Attribute{
+							MarkdownDescription: "Number of tables in the warehouse.",
+							Computed:            true,
+						},
+						"number_of_views": schema.Int64Attribute{
+							MarkdownDescription: "Number of views in the warehouse.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *lakekeeperWarehouseStatisticsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	datasource := req.ProviderData.(*LakekeeperDatasourceData)
+	d.client = datasource.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *lakekeeperWarehouseStatisticsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state lakekeeperWarehouseStatisticsDataSourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var from, to *time.Time
+	if !state.From.IsNull() {
+		t, err := time.Parse(time.RFC3339, state.From.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("from"), "Invalid date", fmt.Sprintf("must be in RFC3339 format, %v", err))
+			return
+		}
+		from = &t
+	}
+	if !state.To.IsNull() {
+		t, err := time.Parse(time.RFC3339, state.To.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("to"), "Invalid date", fmt.Sprintf("must be in RFC3339 format, %v", err))
+			return
+		}
+		to = &t
+	}
+
+	projectID := state.ProjectID.ValueString()
+	warehouseID := state.WarehouseID.ValueString()
+
+	opts := managementv1.GetStatisticsOptions{}
+
+	state.Stats = []lakekeeperWarehouseStatisticsModel{}
+	for {
+		page, _, err := d.client.WarehouseV1(projectID).GetStatistics(ctx, warehouseID, &opts)
+		if err != nil {
+			resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read statistics for warehouse %s, %v", warehouseID, err))
+			return
+		}
+
+		for _, s := range page.Stats {
+			// the API has no time range filter, it is applied on the client side
+			if ts, err := time.Parse(time.RFC3339, s.Timestamp); err == nil {
+				if (from != nil && ts.Before(*from)) || (to != nil && ts.After(*to)) {
+					continue
+				}
+			}
+
+			state.Stats = append(state.Stats, lakekeeperWarehouseStatisticsModel{
+				Timestamp:      types.StringValue(s.Timestamp),
+				UpdatedAt:      types.StringValue(s.UpdatedAt),
+				NumberOfTables: types.Int64Value(s.NumberOfTables),
+				NumberOfViews:  types.Int64Value(s.NumberOfView),
+			})
+		}
+
+		if page.NextPageToken == nil || *page.NextPageToken == "" || len(page.Stats) == 0 {
+			break
+		}
+		opts.PageToken = page.NextPageToken
+	}
+
+	state.ID = types.StringValue(fmt.Sprintf("%s/%s", projectID, warehouseID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,47 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/testutil"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataLakekeeperWarehouseStatistics_basic(t *testing.T) {
+	project := testutil.CreateProject(t)
+
+	keyPrefix := fmt.Sprintf("key-prefix-%d", rand.Int())
+	warehouse := testutil.CreateWarehouse(t, project.ID, keyPrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				data "lakekeeper_warehouse_statistics" "foo" {
+					project_id = "%s"
+					warehouse_id = "%s"
+				}
+				`, project.ID, warehouse.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakekeeper_warehouse_statistics.foo", "id", project.ID+"/"+warehouse.ID),
+					resource.TestCheckResourceAttrSet("data.lakekeeper_warehouse_statistics.foo", "stats.#"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+				data "lakekeeper_warehouse_statistics" "foo" {
+					project_id = "%s"
+					warehouse_id = "%s"
+					to = "2000-01-01T00:00:00Z"
+				}
+				`, project.ID, warehouse.ID),
+				Check: resource.TestCheckResourceAttr("data.lakekeeper_warehouse_statistics.foo", "stats.#", "0"),
+			},
+		},
+	})
+}
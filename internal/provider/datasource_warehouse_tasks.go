@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
+	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &lakekeeperWarehouseTasksDataSource{}
+	_ datasource.DataSourceWithConfigure = &lakekeeperWarehouseTasksDataSource{}
+)
+
+func init() {
+	registerDataSource(NewLakekeeperWarehouseTasksDataSource)
+}
+
+// NewLakekeeperWarehouseTasksDataSource is a helper function to simplify the provider implementation.
+func NewLakekeeperWarehouseTasksDataSource() datasource.DataSource {
+	return &lakekeeperWarehouseTasksDataSource{}
+}
+
+// lakekeeperWarehouseTasksDataSource is the data source implementation.
+type lakekeeperWarehouseTasksDataSource struct {
+	client *lakekeeper.Client
+}
+
+// lakekeeperWarehouseTasksDataSourceModel describes the data source data model.
+type lakekeeperWarehouseTasksDataSourceModel struct {
+	WarehouseID   types.String                   `tfsdk:"warehouse_id"`
+	Statuses      []types.String                 `tfsdk:"statuses"`
+	QueueNames    []types.String                 `tfsdk:"queue_names"`
+	CreatedAfter  types.String                   `tfsdk:"created_after"`
+	CreatedBefore types.String                   `tfsdk:"created_before"`
+	Tasks         []lakekeeperWarehouseTaskModel `tfsdk:"tasks"`
+}
+
+// lakekeeperWarehouseTaskModel describes a task of a warehouse.
+type lakekeeperWarehouseTaskModel struct {
+	TaskID          types.String   `tfsdk:"task_id"`
+	QueueName       types.String   `tfsdk:"queue_name"`
+	Status          types.String   `tfsdk:"status"`
+	EntityType      types.String   `tfsdk:"entity_type"`
+	EntityID        types.String   `tfsdk:"entity_id"`
+	EntityName      []types.String `tfsdk:"entity_name"`
+	Attempt         types.Int64    `tfsdk:"attempt"`
+	Progress        types.Float64  `tfsdk:"progress"`
+	ParentTaskID    types.String   `tfsdk:"parent_task_id"`
+	ScheduledFor    types.String   `tfsdk:"scheduled_for"`
+	StartedAt       types.String   `tfsdk:"started_at"`
+	LastHeartbeatAt types.String   `tfsdk:"last_heartbeat_at"`
+	CreatedAt       types.String   `tfsdk:"created_at"`
+	UpdatedAt       types.String   `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *lakekeeperWarehouseTasksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_warehouse_tasks"
+}
+
+// Schema defines the schema for the data source.
+func (d *lakekeeperWarehouseTasksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	statuses := make([]string, len(api.ValidTaskStatuses))
+	for i, s := range api.ValidTaskStatuses {
+		statuses[i] = string(s)
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`lakekeeper_warehouse_tasks`" + ` data source lists the tasks of a warehouse, e.g. the expiration and purge tasks of soft-deleted tables.
+
+**Upstream API**: [Lakekeeper REST API docs](https://docs.lakekeeper.io/docs/nightly/api/management/#tag/tasks/operation/list_tasks)`,
+
+		Attributes: map[string]schema.Attribute{
+			"warehouse_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the warehouse.",
+				Required:            true,
+			},
+			"statuses": schema.SetAttribute{
+				MarkdownDescription: "Only list the tasks with one of these statuses. Possible values are " + joinCode(statuses) + ".",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(statuses...)),
+				},
+			},
+			"queue_names": schema.SetAttribute{
+				MarkdownDescription: "Only list the tasks of these queues, e.g. `tabular_expiration`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"created_after": schema.StringAttribute{
+				MarkdownDescription: "Only list the tasks created after this date, in RFC3339 format.",
+				Optional:            true,
+			},
+			"created_before": schema.StringAttribute{
+				MarkdownDescription: "Only list the tasks created before this date, in RFC3339 format.",
+				Optional:            true,
+			},
+			"tasks": schema.ListNestedAttribute{
+				MarkdownDescription: "List of tasks.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"task_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the task.",
+							Computed:            true,
+						},
+						"queue_name": schema.StringAttribute{
+							MarkdownDescription: "The queue the task belongs to.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the task.",
+							Computed:            true,
+						},
+						"entity_type": schema.StringAttribute{
+							MarkdownDescription: "The type of the entity the task applies to, e.g. `table`.",
+							Computed:            true,
+						},
+						"entity_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the entity the task applies to.",
+							Computed:            true,
+						},
+						"entity_name": schema.ListAttribute{
+							MarkdownDescription: "The full name of the entity the task applies to, namespace parts followed by the entity name.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"attempt": schema.Int64Attribute{
+							MarkdownDescription: "The current attempt number of the task.",
+							Computed:            true,
+						},
+						"progress": schema.Float64Attribute{
+							MarkdownDescription: "The progress of the task, between 0 and 1.",
+							Computed:            true,
+						},
+						"parent_task_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the parent task, if any.",
+							Computed:            true,
+						},
+						"scheduled_for": schema.StringAttribute{
+							MarkdownDescription: "When the task is scheduled to run.",
+							Computed:            true,
+						},
+						"started_at": schema.StringAttribute{
+							MarkdownDescription: "When the task started.",
+							Computed:            true,
+						},
+						"last_heartbeat_at": schema.StringAttribute{
+							MarkdownDescription: "The last heartbeat of the worker running the task.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the task was created.",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "When the task was last updated.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *lakekeeperWarehouseTasksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	datasource := req.ProviderData.(*LakekeeperDatasourceData)
+	d.client = datasource.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *lakekeeperWarehouseTasksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state lakekeeperWarehouseTasksDataSourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := api.ListTasksOptions{}
+	for _, s := range state.Statuses {
+		opts.Status = append(opts.Status, api.TaskStatus(s.ValueString()))
+	}
+	for _, q := range state.QueueNames {
+		opts.QueueName = append(opts.QueueName, q.ValueString())
+	}
+
+	if !state.CreatedAfter.IsNull() {
+		t, err := time.Parse(time.RFC3339, state.CreatedAfter.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("created_after"), "Invalid date", fmt.Sprintf("must be in RFC3339 format, %v", err))
+			return
+		}
+		opts.CreatedAfter = &t
+	}
+	if !state.CreatedBefore.IsNull() {
+		t, err := time.Parse(time.RFC3339, state.CreatedBefore.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("created_before"), "Invalid date", fmt.Sprintf("must be in RFC3339 format, %v", err))
+			return
+		}
+		opts.CreatedBefore = &t
+	}
+
+	tasks, err := api.ListAllTasks(ctx, d.client, state.WarehouseID.ValueString(), &opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to list tasks for warehouse %s, %v", state.WarehouseID.ValueString(), err))
+		return
+	}
+
+	state.Tasks = make([]lakekeeperWarehouseTaskModel, len(tasks))
+	for i, t := range tasks {
+		state.Tasks[i] = flattenTask(t)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func flattenTask(t *api.Task) lakekeeperWarehouseTaskModel {
+	entityID := t.Entity.TableID
+	if entityID == nil {
+		entityID = t.Entity.ViewID
+	}
+
+	entityName := []types.String{}
+	for _, n := range t.EntityName {
+		entityName = append(entityName, types.StringValue(n))
+	}
+
+	return lakekeeperWarehouseTaskModel{
+		TaskID:          types.StringValue(t.TaskID),
+		QueueName:       types.StringValue(t.QueueName),
+		Status:          types.StringValue(string(t.Status)),
+		EntityType:      types.StringValue(t.Entity.Type),
+		EntityID:        types.StringPointerValue(entityID),
+		EntityName:      entityName,
+		Attempt:         types.Int64Value(t.Attempt),
+		Progress:        types.Float64Value(t.Progress),
+		ParentTaskID:    types.StringPointerValue(t.ParentTaskID),
+		ScheduledFor:    timeValue(t.ScheduledFor),
+		StartedAt:       timeValue(t.StartedAt),
+		LastHeartbeatAt: timeValue(t.LastHeartbeatAt),
+		CreatedAt:       timeValue(t.CreatedAt),
+		UpdatedAt:       timeValue(t.UpdatedAt),
+	}
+}
+
+// timeValue converts an optional time into a RFC3339 string.
+func timeValue(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.Format(time.RFC3339))
+}
+
+// joinCode formats values as a comma separated list of markdown code.
+func joinCode[T ~string](values []T) string {
+	result := ""
+	for i, v := range values {
+		if i > 0 {
+			result += ", "
+		}
+		result += "`" + string(v) + "`"
+	}
+	return result
+}
@@ -0,0 +1,143 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/testutil"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDataLakekeeperWarehouseTasks_basic(t *testing.T) {
+	project := testutil.CreateProject(t)
+
+	keyPrefix := fmt.Sprintf("key-prefix-%d", rand.Int())
+	warehouse := testutil.CreateWarehouse(t, project.ID, keyPrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				data "lakekeeper_warehouse_tasks" "foo" {
+					warehouse_id = "%s"
+					statuses = ["scheduled", "running"]
+				}
+				`, warehouse.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakekeeper_warehouse_tasks.foo", "warehouse_id", warehouse.ID),
+					resource.TestCheckResourceAttr("data.lakekeeper_warehouse_tasks.foo", "tasks.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataLakekeeperWarehouseTasks_mock(t *testing.T) {
+	var pages []map[string]any
+
+	mockLakekeeperServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/token" && r.Method == "POST":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			// nolint - don't need to err check writing the response in the test
+			w.Write([]byte(`{"access_token": "SlAV32hkKG", "token_type": "Bearer", "expires_in": 3600}`))
+		case r.URL.Path == "/management/v1/warehouse/w1/task/list" && r.Method == "POST":
+			var body map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("could not decode list request, %v", err)
+			}
+			pages = append(pages, body)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			if body["page-token"] == nil {
+				// nolint - don't need to err check writing the response in the test
+				w.Write([]byte(`{
+					"tasks": [{
+						"task-id": "t1",
+						"warehouse-id": "w1",
+						"queue-name": "tabular_purge",
+						"entity": {"type": "table", "table-id": "tb1", "warehouse-id": "w1"},
+						"entity-name": ["ns", "tbl"],
+						"status": "scheduled",
+						"attempt": 1,
+						"progress": 0,
+						"scheduled-for": "2025-01-01T10:00:00Z",
+						"created-at": "2025-01-01T09:00:00Z"
+					}],
+					"next-page-token": "next"
+				}`))
+				return
+			}
+			// nolint - don't need to err check writing the response in the test
+			w.Write([]byte(`{
+				"tasks": [{
+					"task-id": "t2",
+					"warehouse-id": "w1",
+					"queue-name": "tabular_purge",
+					"entity": {"type": "table", "table-id": "tb2", "warehouse-id": "w1"},
+					"entity-name": ["ns", "tbl2"],
+					"status": "scheduled",
+					"attempt": 3,
+					"progress": 0.5,
+					"created-at": "2025-01-01T09:00:00Z"
+				}]
+			}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer mockLakekeeperServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				//lintignore:AT004 // Explicitly testing a provider configuration
+				Config: fmt.Sprintf(`
+				provider "lakekeeper" {
+					endpoint = "%s"
+					auth_url = "%s/token"
+					client_id = "test-id"
+					client_secret = "test-secret"
+				}
+
+				data "lakekeeper_warehouse_tasks" "foo" {
+					warehouse_id = "w1"
+					statuses = ["scheduled"]
+					queue_names = ["tabular_purge"]
+				}
+				`, mockLakekeeperServer.URL, mockLakekeeperServer.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakekeeper_warehouse_tasks.foo", "tasks.#", "2"),
+					resource.TestCheckResourceAttr("data.lakekeeper_warehouse_tasks.foo", "tasks.0.task_id", "t1"),
+					resource.TestCheckResourceAttr("data.lakekeeper_warehouse_tasks.foo", "tasks.0.entity_type", "table"),
+					resource.TestCheckResourceAttr("data.lakekeeper_warehouse_tasks.foo", "tasks.0.entity_id", "tb1"),
+					resource.TestCheckResourceAttr("data.lakekeeper_warehouse_tasks.foo", "tasks.0.entity_name.#", "2"),
+					resource.TestCheckResourceAttr("data.lakekeeper_warehouse_tasks.foo", "tasks.0.scheduled_for", "2025-01-01T10:00:00Z"),
+					resource.TestCheckResourceAttr("data.lakekeeper_warehouse_tasks.foo", "tasks.1.task_id", "t2"),
+					resource.TestCheckResourceAttr("data.lakekeeper_warehouse_tasks.foo", "tasks.1.attempt", "3"),
+					resource.TestCheckNoResourceAttr("data.lakekeeper_warehouse_tasks.foo", "tasks.1.scheduled_for"),
+					func(*terraform.State) error {
+						if len(pages) < 2 {
+							return fmt.Errorf("expected at least 2 list requests, got %d", len(pages))
+						}
+						if fmt.Sprint(pages[0]["status"]) != "[scheduled]" || fmt.Sprint(pages[0]["queue-name"]) != "[tabular_purge]" {
+							return fmt.Errorf("unexpected list request, got %v", pages[0])
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
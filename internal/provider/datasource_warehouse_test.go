@@ -43,3 +43,59 @@ func TestAccDataLakekeeperWarehouse_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDataLakekeeperWarehouse_byName(t *testing.T) {
+
+	project := testutil.CreateProject(t)
+
+	keyPrefix := acctest.RandString(8)
+	warehouse := testutil.CreateWarehouse(t, project.ID, keyPrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				data "lakekeeper_warehouse" "foo" {
+					name = "%s"
+					project_id = "%s"
+				}`, warehouse.Name, project.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakekeeper_warehouse.foo", "id", project.ID+"/"+warehouse.ID),
+					resource.TestCheckResourceAttr("data.lakekeeper_warehouse.foo", "warehouse_id", warehouse.ID),
+					resource.TestCheckResourceAttr("data.lakekeeper_warehouse.foo", "name", warehouse.Name),
+					resource.TestCheckResourceAttr("data.lakekeeper_warehouse.foo", "storage_profile.s3.key_prefix", keyPrefix),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataLakekeeperWarehouse_byNameInactive(t *testing.T) {
+
+	project := testutil.CreateProject(t)
+
+	keyPrefix := acctest.RandString(8)
+	warehouse := testutil.CreateWarehouse(t, project.ID, keyPrefix)
+
+	if _, err := testutil.TestLakekeeperClient.WarehouseV1(project.ID).Deactivate(t.Context(), warehouse.ID); err != nil {
+		t.Fatalf("could not deactivate test warehouse: %v", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				data "lakekeeper_warehouse" "foo" {
+					name = "%s"
+					project_id = "%s"
+				}`, warehouse.Name, project.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakekeeper_warehouse.foo", "warehouse_id", warehouse.ID),
+					resource.TestCheckResourceAttr("data.lakekeeper_warehouse.foo", "active", "false"),
+				),
+			},
+		},
+	})
+}
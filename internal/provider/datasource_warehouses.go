@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	managementv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1"
+	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &lakekeeperWarehousesDataSource{}
+	_ datasource.DataSourceWithConfigure = &lakekeeperWarehousesDataSource{}
+)
+
+func init() {
+	registerDataSource(NewLakekeeperWarehousesDataSource)
+}
+
+// NewLakekeeperWarehousesDataSource is a helper function to simplify the provider implementation.
+func NewLakekeeperWarehousesDataSource() datasource.DataSource {
+	return &lakekeeperWarehousesDataSource{}
+}
+
+// lakekeeperWarehousesDataSource is the data source implementation.
+type lakekeeperWarehousesDataSource struct {
+	client *lakekeeper.Client
+}
+
+// lakekeeperWarehousesDataSourceModel describes the data source data model.
+type lakekeeperWarehousesDataSourceModel struct {
+	ID              types.String                   `tfsdk:"id"`
+	ProjectID       types.String                   `tfsdk:"project_id"`
+	IncludeInactive types.Bool                     `tfsdk:"include_inactive"`
+	Warehouses      []lakekeeperWarehouseItemModel `tfsdk:"warehouses"`
+}
+
+// lakekeeperWarehouseItemModel describes a warehouse of the list.
+type lakekeeperWarehouseItemModel struct {
+	ID          types.String `tfsdk:"id"`
+	WarehouseID types.String `tfsdk:"warehouse_id"`
+	ProjectID   types.String `tfsdk:"project_id"`
+	Name        types.String `tfsdk:"name"`
+	Protected   types.Bool   `tfsdk:"protected"`
+	Active      types.Bool   `tfsdk:"active"`
+}
+
+// Metadata returns the data source type name.
+func (d *lakekeeperWarehousesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_warehouses"
+}
+
+// Schema defines the schema for the data source.
+func (d *lakekeeperWarehousesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`lakekeeper_warehouses`" + ` data source lists the warehouses of a project.
+
+**Upstream API**: [Lakekeeper REST API docs](https://docs.lakekeeper.io/docs/nightly/api/management/#tag/warehouse/operation/list_warehouses)`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this data source, the project ID.",
+				Computed:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project.",
+				Required:            true,
+			},
+			"include_inactive": schema.BoolAttribute{
+				MarkdownDescription: "Whether the inactive warehouses are listed. Defaults to `false`.",
+				Optional:            true,
+			},
+			"warehouses": schema.ListNestedAttribute{
+				MarkdownDescription: "List of warehouses.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The internal ID the warehouse. In the form: {{project_id}}/{{warehouse_id}}",
+							Computed:            true,
+						},
+						"warehouse_id": schema.StringAttribute{
+							MarkdownDescription: "The ID the warehouse.",
+							Computed:            true,
+						},
+						"project_id": schema.StringAttribute{
+							MarkdownDescription: "The project ID to which the warehouse belongs.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the warehouse.",
+							Computed:            true,
+						},
+						"protected": schema.BoolAttribute{
+							MarkdownDescription: "Whether the warehouse is protected from being deleted.",
+							Computed:            true,
+						},
+						"active": schema.BoolAttribute{
+							MarkdownDescription: "Whether the warehouse is active.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *lakekeeperWarehousesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	datasource := req.ProviderData.(*LakekeeperDatasourceData)
+	d.client = datasource.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *lakekeeperWarehousesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state lakekeeperWarehousesDataSourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := state.ProjectID.ValueString()
+
+	// only the active warehouses are listed by default
+	opts := managementv1.ListWarehouseOptions{
+		WarehouseStatus: []managementv1.WarehouseStatus{managementv1.WarehouseStatusActive},
+	}
+	if state.IncludeInactive.ValueBool() {
+		opts.WarehouseStatus = append(opts.WarehouseStatus, managementv1.WarehouseStatusInactive)
+	}
+
+	warehouses, _, err := d.client.WarehouseV1(projectID).List(ctx, &opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to list warehouses in project %s, %v", projectID, err))
+		return
+	}
+
+	state.Warehouses = []lakekeeperWarehouseItemModel{}
+	for _, w := range warehouses.Warehouses {
+		state.Warehouses = append(state.Warehouses, lakekeeperWarehouseItemModel{
+			ID:          types.StringValue(fmt.Sprintf("%s/%s", w.ProjectID, w.ID)),
+			WarehouseID: types.StringValue(w.ID),
+			ProjectID:   types.StringValue(w.ProjectID),
+			Name:        types.StringValue(w.Name),
+			Protected:   types.BoolValue(w.Protected),
+			Active:      types.BoolValue(w.IsActive()),
+		})
+	}
+
+	state.ID = types.StringValue(projectID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
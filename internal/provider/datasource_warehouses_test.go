@@ -0,0 +1,55 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/testutil"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataLakekeeperWarehouses_basic(t *testing.T) {
+
+	project := testutil.CreateProject(t)
+	active := testutil.CreateWarehouse(t, project.ID, acctest.RandString(8))
+	inactive := testutil.CreateWarehouse(t, project.ID, acctest.RandString(8))
+
+	if _, err := testutil.TestLakekeeperClient.WarehouseV1(project.ID).Deactivate(t.Context(), inactive.ID); err != nil {
+		t.Fatalf("could not deactivate test warehouse: %v", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				data "lakekeeper_warehouses" "active" {
+					project_id = "%s"
+				}
+				data "lakekeeper_warehouses" "all" {
+					project_id = "%s"
+					include_inactive = true
+				}`, project.ID, project.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.lakekeeper_warehouses.active", "id", project.ID),
+					resource.TestCheckResourceAttr("data.lakekeeper_warehouses.active", "warehouses.#", "1"),
+					resource.TestCheckResourceAttr("data.lakekeeper_warehouses.active", "warehouses.0.id", fmt.Sprintf("%s/%s", project.ID, active.ID)),
+					resource.TestCheckResourceAttr("data.lakekeeper_warehouses.active", "warehouses.0.warehouse_id", active.ID),
+					resource.TestCheckResourceAttr("data.lakekeeper_warehouses.active", "warehouses.0.project_id", project.ID),
+					resource.TestCheckResourceAttr("data.lakekeeper_warehouses.active", "warehouses.0.name", active.Name),
+					resource.TestCheckResourceAttr("data.lakekeeper_warehouses.active", "warehouses.0.active", "true"),
+
+					resource.TestCheckResourceAttr("data.lakekeeper_warehouses.all", "warehouses.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.lakekeeper_warehouses.all", "warehouses.*", map[string]string{
+						"warehouse_id": inactive.ID,
+						"name":         inactive.Name,
+						"active":       "false",
+					}),
+				),
+			},
+		},
+	})
+}
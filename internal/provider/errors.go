@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/apache/iceberg-go/catalog"
+	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
+	"github.com/baptistegh/go-lakekeeper/pkg/core"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Features backed by endpoints which are not available on every Lakekeeper version.
+const (
+	capabilityWarehouseProtection = "warehouse protection"
+	capabilityDeleteProfile       = "warehouse delete profile"
+	capabilityManagedAccess       = "warehouse managed access"
+	capabilityNamespaceProtection = "namespace protection"
+)
+
+// isUnsupportedEndpointError returns true when the server answered that the
+// requested route does not exist. Missing objects are reported by Lakekeeper
+// with an error body, unknown routes are not.
+func isUnsupportedEndpointError(err error) bool {
+	var apiErr *core.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	if apiErr.Response != nil {
+		return false
+	}
+
+	return apiErr.StatusCode == http.StatusNotFound || apiErr.StatusCode == http.StatusMethodNotAllowed
+}
+
+// isNotFoundError returns true when the server answered that the requested object
+// does not exist, as opposed to an unknown route.
+func isNotFoundError(err error) bool {
+	if errors.Is(err, catalog.ErrNoSuchNamespace) {
+		return true
+	}
+
+	var apiErr *core.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	return apiErr.Response != nil && apiErr.StatusCode == http.StatusNotFound
+}
+
+// removeMissingResource removes from the state a resource deleted outside of Terraform,
+// so that it is planned for creation. The identity is kept as the framework requires one.
+func removeMissingResource(ctx context.Context, identity resourceIdentity, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+
+	tflog.Warn(ctx, "resource not found, removing it from the state", map[string]any{
+		"id": id.ValueString(),
+	})
+
+	resp.Diagnostics.Append(identity.Set(ctx, req.State, resp.Identity)...)
+	resp.State.RemoveResource(ctx)
+}
+
+// capabilityError explains that the server does not support the given capability
+// when err comes from an endpoint unknown to the server, err is returned as is otherwise.
+func capabilityError(ctx context.Context, client *lakekeeper.Client, capability string, err error) error {
+	if !isUnsupportedEndpointError(err) {
+		return err
+	}
+
+	version := "unknown"
+	if info, _, infoErr := client.ServerV1().Info(ctx); infoErr == nil {
+		version = info.Version
+	}
+
+	return fmt.Errorf("%s is not supported by the Lakekeeper server (version %s), upgrade the server to use this feature: %w", capability, version, err)
+}
@@ -0,0 +1,568 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/apache/iceberg-go/catalog/rest"
+	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/api"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// exportedType is a resource type walked by the export, through its list resource.
+type exportedType struct {
+	newListResource func() list.ListResource
+	identity        resourceIdentity
+	// references are the attributes of the other resources holding the ID of this resource,
+	// with the attribute of this resource to reference instead.
+	references map[string]string
+}
+
+var (
+	exportedProject = exportedType{
+		newListResource: NewLakekeeperProjectListResource,
+		identity:        projectIdentity,
+		references:      map[string]string{"project_id": "id"},
+	}
+	exportedUser = exportedType{
+		newListResource: NewLakekeeperUserListResource,
+		identity:        userIdentity,
+		references:      map[string]string{"user_id": "id"},
+	}
+	exportedRole = exportedType{
+		newListResource: NewLakekeeperRoleListResource,
+		identity:        roleIdentity,
+		references:      map[string]string{"role_id": "role_id", "assignee_id": "role_id"},
+	}
+	exportedWarehouse = exportedType{
+		newListResource: NewLakekeeperWarehouseListResource,
+		identity:        warehouseIdentity,
+		references:      map[string]string{"warehouse_id": "warehouse_id"},
+	}
+	exportedNamespace = exportedType{
+		newListResource: NewLakekeeperNamespaceListResource,
+		identity:        namespaceIdentity,
+	}
+	exportedProjectRoleAssignment   = exportedType{newListResource: NewLakekeeperProjectRoleAssignmentListResource, identity: projectRoleAssignmentIdentity}
+	exportedProjectUserAssignment   = exportedType{newListResource: NewLakekeeperProjectUserAssignmentListResource, identity: projectUserAssignmentIdentity}
+	exportedRoleRoleAssignment      = exportedType{newListResource: NewLakekeeperRoleRoleAssignmentListResource, identity: roleRoleAssignmentIdentity}
+	exportedRoleUserAssignment      = exportedType{newListResource: NewLakekeeperRoleUserAssignmentListResource, identity: roleUserAssignmentIdentity}
+	exportedServerRoleAssignment    = exportedType{newListResource: NewLakekeeperServerRoleAssignmentListResource, identity: serverRoleAssignmentIdentity}
+	exportedServerUserAssignment    = exportedType{newListResource: NewLakekeeperServerUserAssignmentListResource, identity: serverUserAssignmentIdentity}
+	exportedWarehouseRoleAssignment = exportedType{newListResource: NewLakekeeperWarehouseRoleAssignmentListResource, identity: warehouseRoleAssignmentIdentity}
+	exportedWarehouseUserAssignment = exportedType{newListResource: NewLakekeeperWarehouseUserAssignmentListResource, identity: warehouseUserAssignmentIdentity}
+)
+
+// exportedResource is a resource written by the export.
+type exportedResource struct {
+	typeName string
+	label    string
+	state    tfsdk.State
+}
+
+// exporter writes the configuration of the existing Lakekeeper objects.
+type exporter struct {
+	resourceData *LakekeeperResourceData
+	files        map[string]*hclwrite.File
+	// labels are the labels already used, by resource type.
+	labels map[string]map[string]bool
+	// references are the traversals to the exported resources, by attribute name and ID.
+	references map[[2]string]hcl.Traversal
+	// names are the labels of the exported resources, by ID, used to name the assignments.
+	names map[string]string
+}
+
+// Export writes the configuration of the Lakekeeper objects visible with config to dir:
+// projects, users, roles, warehouses, namespaces and all the assignments. There is a file
+// per resource type and an imports.tf file with the import blocks adopting them.
+// Only read requests are sent to Lakekeeper.
+func Export(ctx context.Context, config api.Config, dir string) error {
+	config.ReadOnly = true
+
+	client, err := config.NewLakekeeperClient(ctx)
+	if err != nil {
+		return fmt.Errorf("could not create the Lakekeeper client, %w", err)
+	}
+
+	transport, err := config.NewTransport()
+	if err != nil {
+		return fmt.Errorf("could not create the HTTP transport, %w", err)
+	}
+
+	e := &exporter{
+		resourceData: &LakekeeperResourceData{
+			Client:         client,
+			CatalogOptions: []rest.Option{rest.WithCustomTransport(transport)},
+		},
+		files:      make(map[string]*hclwrite.File),
+		labels:     make(map[string]map[string]bool),
+		references: make(map[[2]string]hcl.Traversal),
+		names:      make(map[string]string),
+	}
+
+	if err := e.walk(ctx); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	for name, f := range e.files {
+		if err := os.WriteFile(filepath.Join(dir, name), f.Bytes(), 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// walk exports the resources, the referenced resources first.
+func (e *exporter) walk(ctx context.Context) error {
+	projects, err := e.export(ctx, exportedProject, nil, "")
+	if err != nil {
+		return err
+	}
+
+	if _, err := e.export(ctx, exportedUser, nil, ""); err != nil {
+		return err
+	}
+
+	if _, err := e.export(ctx, exportedServerRoleAssignment, nil, "server"); err != nil {
+		return err
+	}
+	if _, err := e.export(ctx, exportedServerUserAssignment, nil, "server"); err != nil {
+		return err
+	}
+
+	for _, project := range projects {
+		projectID := stateString(ctx, project.state, "id")
+		projectConfig := map[string]string{"project_id": projectID}
+
+		roles, err := e.export(ctx, exportedRole, projectConfig, "")
+		if err != nil {
+			return err
+		}
+
+		if _, err := e.export(ctx, exportedProjectRoleAssignment, projectConfig, project.label); err != nil {
+			return err
+		}
+		if _, err := e.export(ctx, exportedProjectUserAssignment, projectConfig, project.label); err != nil {
+			return err
+		}
+
+		for _, role := range roles {
+			roleConfig := map[string]string{"role_id": stateString(ctx, role.state, "role_id")}
+
+			if _, err := e.export(ctx, exportedRoleRoleAssignment, roleConfig, role.label); err != nil {
+				return err
+			}
+			if _, err := e.export(ctx, exportedRoleUserAssignment, roleConfig, role.label); err != nil {
+				return err
+			}
+		}
+
+		warehouses, err := e.export(ctx, exportedWarehouse, projectConfig, "")
+		if err != nil {
+			return err
+		}
+
+		for _, warehouse := range warehouses {
+			warehouseConfig := map[string]string{"warehouse_id": stateString(ctx, warehouse.state, "warehouse_id")}
+
+			if _, err := e.export(ctx, exportedWarehouseRoleAssignment, warehouseConfig, warehouse.label); err != nil {
+				return err
+			}
+			if _, err := e.export(ctx, exportedWarehouseUserAssignment, warehouseConfig, warehouse.label); err != nil {
+				return err
+			}
+
+			if !stateBool(ctx, warehouse.state, "active") {
+				// the catalog of an inactive warehouse cannot be browsed
+				continue
+			}
+
+			namespaceConfig := map[string]string{"project_id": projectID, "warehouse_name": stateString(ctx, warehouse.state, "name")}
+			if _, err := e.export(ctx, exportedNamespace, namespaceConfig, warehouse.label); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// export lists the resources of the given type with the list resource config, then
+// writes their configuration and import blocks. The labels of the resources are
+// prefixed by labelPrefix, if any.
+func (e *exporter) export(ctx context.Context, t exportedType, config map[string]string, labelPrefix string) ([]exportedResource, error) {
+	lr := t.newListResource()
+	r := lr.(resource.ResourceWithIdentity)
+
+	var metadataResp resource.MetadataResponse
+	r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "lakekeeper"}, &metadataResp)
+	typeName := metadataResp.TypeName
+
+	if c, ok := lr.(list.ListResourceWithConfigure); ok {
+		c.Configure(ctx, resource.ConfigureRequest{ProviderData: e.resourceData}, &resource.ConfigureResponse{})
+	}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	var identityResp resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+	var configResp list.ListResourceSchemaResponse
+	lr.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &configResp)
+
+	configValues := make(map[string]tftypes.Value)
+	for name := range configResp.Schema.Attributes {
+		if v, ok := config[name]; ok {
+			configValues[name] = tftypes.NewValue(tftypes.String, v)
+		} else {
+			configValues[name] = tftypes.NewValue(tftypes.String, nil)
+		}
+	}
+
+	req := list.ListRequest{
+		Config: tfsdk.Config{
+			Raw:    tftypes.NewValue(configResp.Schema.Type().TerraformType(ctx), configValues),
+			Schema: configResp.Schema,
+		},
+		IncludeResource:        true,
+		ResourceSchema:         schemaResp.Schema,
+		ResourceIdentitySchema: identityResp.IdentitySchema,
+	}
+
+	var stream list.ListResultsStream
+	lr.List(ctx, req, &stream)
+	if stream.Results == nil {
+		return nil, nil
+	}
+
+	var exported []exportedResource
+	for result := range stream.Results {
+		if err := diagnosticsError(result.Diagnostics); err != nil {
+			return nil, fmt.Errorf("could not export %s, %w", typeName, err)
+		}
+		if result.Resource == nil || result.Resource.Raw.IsNull() {
+			// removed while listing
+			continue
+		}
+
+		state := tfsdk.State{Raw: result.Resource.Raw, Schema: result.Resource.Schema}
+
+		values := make([]string, len(t.identity))
+		for i, a := range t.identity {
+			var v types.String
+			if err := diagnosticsError(result.Identity.GetAttribute(ctx, path.Root(a.Name), &v)); err != nil {
+				return nil, fmt.Errorf("could not export %s, %w", typeName, err)
+			}
+			values[i] = v.ValueString()
+		}
+
+		// the assignments are named after their assignee
+		name := result.DisplayName
+		if n, ok := e.names[name]; ok {
+			name = n
+		}
+		if labelPrefix != "" {
+			name = labelPrefix + "_" + name
+		}
+
+		res := exportedResource{
+			typeName: typeName,
+			label:    e.label(typeName, name),
+			state:    state,
+		}
+
+		if err := e.write(ctx, res, schemaResp.Schema, strings.Join(values, "/")); err != nil {
+			return nil, fmt.Errorf("could not export %s %s, %w", typeName, res.label, err)
+		}
+
+		id := values[len(values)-1]
+		if t.references != nil {
+			e.names[id] = res.label
+		}
+		for attribute, target := range t.references {
+			e.references[[2]string{attribute, id}] = hcl.Traversal{
+				hcl.TraverseRoot{Name: typeName},
+				hcl.TraverseAttr{Name: res.label},
+				hcl.TraverseAttr{Name: target},
+			}
+		}
+
+		exported = append(exported, res)
+	}
+
+	return exported, nil
+}
+
+// write appends the resource block to the file of its type, and its import block to imports.tf.
+func (e *exporter) write(ctx context.Context, res exportedResource, s schema.Schema, importID string) error {
+	var values map[string]tftypes.Value
+	if err := res.state.Raw.As(&values); err != nil {
+		return err
+	}
+
+	block := hclwrite.NewBlock("resource", []string{res.typeName, res.label})
+
+	var missing []string
+	for _, name := range sortedAttributes(s.Attributes) {
+		a := s.Attributes[name]
+		v := values[name]
+
+		if a.IsRequired() && v.IsNull() {
+			missing = append(missing, name)
+			continue
+		}
+		if omitAttribute(ctx, a, v) {
+			continue
+		}
+
+		if v.Type().Is(tftypes.String) {
+			var id string
+			if err := v.As(&id); err != nil {
+				return err
+			}
+			if traversal, ok := e.references[[2]string{name, id}]; ok {
+				block.Body().SetAttributeTraversal(name, traversal)
+				continue
+			}
+		}
+
+		value, err := ctyValue(ctx, a, v, name, &missing)
+		if err != nil {
+			return err
+		}
+		if omitEmptyObject(a, value) {
+			continue
+		}
+		block.Body().SetAttributeValue(name, value)
+	}
+
+	body := e.file(res.typeName + ".tf").Body()
+	for _, m := range missing {
+		body.AppendUnstructuredTokens(hclwrite.Tokens{{
+			Type:  hclsyntax.TokenComment,
+			Bytes: fmt.Appendf(nil, "# TODO: %s is not returned by Lakekeeper, set it before planning.\n", m),
+		}})
+	}
+	body.AppendBlock(block)
+	body.AppendNewline()
+
+	imports := e.file("imports.tf").Body()
+	importBlock := imports.AppendNewBlock("import", nil)
+	importBlock.Body().SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: res.typeName},
+		hcl.TraverseAttr{Name: res.label},
+	})
+	importBlock.Body().SetAttributeValue("id", cty.StringVal(importID))
+	imports.AppendNewline()
+
+	return nil
+}
+
+func (e *exporter) file(name string) *hclwrite.File {
+	if _, ok := e.files[name]; !ok {
+		e.files[name] = hclwrite.NewEmptyFile()
+	}
+	return e.files[name]
+}
+
+var invalidLabelChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// label returns a label unique for the resource type, derived from name.
+func (e *exporter) label(typeName, name string) string {
+	base := strings.Trim(invalidLabelChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if base == "" || (base[0] >= '0' && base[0] <= '9') {
+		base = "r_" + base
+	}
+
+	if e.labels[typeName] == nil {
+		e.labels[typeName] = make(map[string]bool)
+	}
+
+	label := base
+	for i := 2; e.labels[typeName][label]; i++ {
+		label = fmt.Sprintf("%s_%d", base, i)
+	}
+	e.labels[typeName][label] = true
+
+	return label
+}
+
+// omitAttribute returns whether the attribute must be left out of the configuration: its value
+// is null, it cannot be configured, or it is the default value.
+func omitAttribute(ctx context.Context, a schema.Attribute, v tftypes.Value) bool {
+	if v.IsNull() || a.IsWriteOnly() || (a.IsComputed() && !a.IsOptional()) {
+		return true
+	}
+
+	var defaultValue attr.Value
+	switch a := a.(type) {
+	case schema.BoolAttribute:
+		if a.Default != nil {
+			var resp defaults.BoolResponse
+			a.Default.DefaultBool(ctx, defaults.BoolRequest{}, &resp)
+			defaultValue = resp.PlanValue
+		}
+	case schema.StringAttribute:
+		if a.Default != nil {
+			var resp defaults.StringResponse
+			a.Default.DefaultString(ctx, defaults.StringRequest{}, &resp)
+			defaultValue = resp.PlanValue
+		}
+	case schema.Int64Attribute:
+		if a.Default != nil {
+			var resp defaults.Int64Response
+			a.Default.DefaultInt64(ctx, defaults.Int64Request{}, &resp)
+			defaultValue = resp.PlanValue
+		}
+	}
+	if defaultValue == nil {
+		return false
+	}
+
+	d, err := defaultValue.ToTerraformValue(ctx)
+	return err == nil && d.Equal(v)
+}
+
+// omitEmptyObject returns whether the value is an empty object of an optional attribute,
+// all its attributes being omitted.
+func omitEmptyObject(a schema.Attribute, v cty.Value) bool {
+	return !a.IsRequired() && v.Type().IsObjectType() && v.LengthInt() == 0
+}
+
+// ctyValue converts the value of the attribute, leaving out the nested attributes which
+// must be omitted. The required nested attributes missing from the value are appended to
+// missing, by their path.
+func ctyValue(ctx context.Context, a schema.Attribute, v tftypes.Value, attrPath string, missing *[]string) (cty.Value, error) {
+	if nested, ok := a.(schema.SingleNestedAttribute); ok {
+		var values map[string]tftypes.Value
+		if err := v.As(&values); err != nil {
+			return cty.NilVal, err
+		}
+
+		attributes := make(map[string]cty.Value)
+		for _, name := range sortedAttributes(nested.Attributes) {
+			na := nested.Attributes[name]
+			nv := values[name]
+
+			if na.IsRequired() && nv.IsNull() {
+				*missing = append(*missing, attrPath+"."+name)
+				continue
+			}
+			if omitAttribute(ctx, na, nv) {
+				continue
+			}
+
+			value, err := ctyValue(ctx, na, nv, attrPath+"."+name, missing)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			if omitEmptyObject(na, value) {
+				continue
+			}
+			attributes[name] = value
+		}
+
+		return cty.ObjectVal(attributes), nil
+	}
+
+	return ctyPrimitiveValue(v)
+}
+
+// ctyPrimitiveValue converts a primitive value, or a collection of primitive values.
+func ctyPrimitiveValue(v tftypes.Value) (cty.Value, error) {
+	switch {
+	case v.Type().Is(tftypes.String):
+		var s string
+		err := v.As(&s)
+		return cty.StringVal(s), err
+	case v.Type().Is(tftypes.Bool):
+		var b bool
+		err := v.As(&b)
+		return cty.BoolVal(b), err
+	case v.Type().Is(tftypes.Number):
+		n := new(big.Float)
+		err := v.As(&n)
+		return cty.NumberVal(n), err
+	case v.Type().Is(tftypes.List{}), v.Type().Is(tftypes.Set{}):
+		var elems []tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return cty.NilVal, err
+		}
+		values := make([]cty.Value, len(elems))
+		for i, elem := range elems {
+			value, err := ctyPrimitiveValue(elem)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			values[i] = value
+		}
+		return cty.TupleVal(values), nil
+	default:
+		return cty.NilVal, fmt.Errorf("unsupported type %s", v.Type())
+	}
+}
+
+// sortedAttributes returns the names of the required attributes, then the others, alphabetically.
+func sortedAttributes(attributes map[string]schema.Attribute) []string {
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+
+	slices.SortFunc(names, func(a, b string) int {
+		if ra, rb := attributes[a].IsRequired(), attributes[b].IsRequired(); ra != rb {
+			if ra {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a, b)
+	})
+
+	return names
+}
+
+func stateString(ctx context.Context, state tfsdk.State, name string) string {
+	var v types.String
+	state.GetAttribute(ctx, path.Root(name), &v)
+	return v.ValueString()
+}
+
+func stateBool(ctx context.Context, state tfsdk.State, name string) bool {
+	var v types.Bool
+	state.GetAttribute(ctx, path.Root(name), &v)
+	return v.ValueBool()
+}
+
+// diagnosticsError returns the errors of diags as an error, nil if there are none.
+func diagnosticsError(diags diag.Diagnostics) error {
+	var errs []error
+	for _, d := range diags.Errors() {
+		errs = append(errs, fmt.Errorf("%s: %s", d.Summary(), d.Detail()))
+	}
+	return errors.Join(errs...)
+}
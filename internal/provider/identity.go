@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// identityAttribute is a string attribute of a resource identity.
+type identityAttribute struct {
+	// Name is the name of the attribute in the identity schema.
+	Name string
+	// StateAttribute is the name of the matching attribute in the resource schema,
+	// it defaults to Name.
+	StateAttribute string
+	Description    string
+}
+
+func (a identityAttribute) statePath() path.Path {
+	if a.StateAttribute != "" {
+		return path.Root(a.StateAttribute)
+	}
+	return path.Root(a.Name)
+}
+
+// resourceIdentity describes the identity of a resource. The import ID of the
+// resource is the identity attributes joined with a slash, in the same order.
+type resourceIdentity []identityAttribute
+
+// Schema returns the identity schema, all the attributes are required for import.
+func (ri resourceIdentity) Schema() identityschema.Schema {
+	attributes := make(map[string]identityschema.Attribute, len(ri))
+	for _, a := range ri {
+		attributes[a.Name] = identityschema.StringAttribute{
+			Description:       a.Description,
+			RequiredForImport: true,
+		}
+	}
+
+	return identityschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// format returns the expected import ID format, e.g. project_id/role_id.
+func (ri resourceIdentity) format() string {
+	names := make([]string, len(ri))
+	for i, a := range ri {
+		names[i] = a.Name
+	}
+	return strings.Join(names, "/")
+}
+
+// ImportState sets the identity attributes and the id in the state, either from
+// the import ID or from the identity of an import block.
+func (ri resourceIdentity) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	values := make([]string, len(ri))
+
+	if req.ID != "" {
+		parts := strings.Split(req.ID, "/")
+		if len(parts) != len(ri) {
+			resp.Diagnostics.AddError(
+				"Invalid import ID format",
+				fmt.Sprintf("Expected format: %s, got: %s", ri.format(), req.ID),
+			)
+			return
+		}
+		copy(values, parts)
+	} else {
+		for i, a := range ri {
+			var v types.String
+			resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(a.Name), &v)...)
+			values[i] = v.ValueString()
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	for i, a := range ri {
+		if values[i] == "" {
+			resp.Diagnostics.AddError(
+				"Invalid import identity",
+				fmt.Sprintf("The attribute %s must not be empty.", a.Name),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, a.statePath(), values[i])...)
+		if resp.Identity != nil {
+			resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root(a.Name), values[i])...)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strings.Join(values, "/"))...)
+}
+
+// Set sets the identity from the identity attributes of the state.
+func (ri resourceIdentity) Set(ctx context.Context, state tfsdk.State, identity *tfsdk.ResourceIdentity) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if identity == nil || state.Raw.IsNull() {
+		return diags
+	}
+
+	for _, a := range ri {
+		var v types.String
+		diags.Append(state.GetAttribute(ctx, a.statePath(), &v)...)
+		if diags.HasError() {
+			return diags
+		}
+		diags.Append(identity.SetAttribute(ctx, path.Root(a.Name), v)...)
+	}
+
+	return diags
+}
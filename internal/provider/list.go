@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"iter"
+
+	permissionv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1/permission"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// listedResource is a resource found by a list resource.
+type listedResource struct {
+	// ImportID is the ID used to import the resource.
+	ImportID    string
+	DisplayName string
+}
+
+// listResults returns the results of the listed resources, at most req.Limit of them.
+// Each resource is imported from its import ID, then read when the resource is requested,
+// the same way `terraform import` does.
+func listResults(ctx context.Context, req list.ListRequest, r resource.ResourceWithImportState, listed []listedResource) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i, l := range listed {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+			if !push(listResult(ctx, req, r, l)) {
+				return
+			}
+		}
+	}
+}
+
+func listResult(ctx context.Context, req list.ListRequest, r resource.ResourceWithImportState, l listedResource) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = l.DisplayName
+
+	importResp := resource.ImportStateResponse{
+		State:    tfsdk.State{Raw: result.Resource.Raw, Schema: result.Resource.Schema},
+		Identity: result.Identity,
+	}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: l.ImportID}, &importResp)
+	result.Diagnostics.Append(importResp.Diagnostics...)
+
+	if !req.IncludeResource || result.Diagnostics.HasError() {
+		return result
+	}
+
+	readResp := resource.ReadResponse{State: importResp.State, Identity: result.Identity}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State, Identity: result.Identity}, &readResp)
+	result.Diagnostics.Append(readResp.Diagnostics...)
+	result.Resource.Raw = readResp.State.Raw
+
+	return result
+}
+
+// assignees returns the distinct IDs of the principals of the given type in the assignments, in order.
+func assignees[T permissionv1.Assignment](assignments []T, principalType permissionv1.UserOrRoleType) []string {
+	seen := make(map[string]struct{})
+
+	var ids []string
+	for _, a := range assignments {
+		if a.GetPrincipalType() != principalType {
+			continue
+		}
+		if _, ok := seen[a.GetPrincipalID()]; ok {
+			continue
+		}
+		seen[a.GetPrincipalID()] = struct{}{}
+		ids = append(ids, a.GetPrincipalID())
+	}
+
+	return ids
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	managementv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1"
+	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
+)
+
+// listRoles returns all the roles of the project matching opts, following the pagination.
+func listRoles(ctx context.Context, client *lakekeeper.Client, projectID string, opts *managementv1.ListRolesOptions) ([]*managementv1.Role, error) {
+	if opts == nil {
+		opts = &managementv1.ListRolesOptions{}
+	}
+
+	var roles []*managementv1.Role
+	for {
+		page, _, err := client.RoleV1(projectID).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		roles = append(roles, page.Roles...)
+
+		if page.NextPageToken == nil || *page.NextPageToken == "" || len(page.Roles) == 0 {
+			return roles, nil
+		}
+		opts.PageToken = page.NextPageToken
+	}
+}
+
+// listUsers returns all the users matching opts, following the pagination.
+func listUsers(ctx context.Context, client *lakekeeper.Client, opts *managementv1.ListUsersOptions) ([]*managementv1.User, error) {
+	if opts == nil {
+		opts = &managementv1.ListUsersOptions{}
+	}
+
+	var users []*managementv1.User
+	for {
+		page, _, err := client.UserV1().List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, page.Users...)
+
+		if page.NextPageToken == nil || *page.NextPageToken == "" || len(page.Users) == 0 {
+			return users, nil
+		}
+		opts.PageToken = page.NextPageToken
+	}
+}
+
+// findWarehouseByName returns the warehouse of the project with the given name, active or not.
+func findWarehouseByName(ctx context.Context, client *lakekeeper.Client, projectID, name string) (*managementv1.Warehouse, error) {
+	resp, _, err := client.WarehouseV1(projectID).List(ctx, &managementv1.ListWarehouseOptions{
+		WarehouseStatus: []managementv1.WarehouseStatus{managementv1.WarehouseStatusActive, managementv1.WarehouseStatusInactive},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []*managementv1.Warehouse
+	for _, w := range resp.Warehouses {
+		if w.Name == name {
+			matches = append(matches, w)
+		}
+	}
+
+	return uniqueMatch(matches, fmt.Sprintf("warehouse named %q in project %s", name, projectID))
+}
+
+// findRoleByName returns the role of the project with the given name.
+func findRoleByName(ctx context.Context, client *lakekeeper.Client, projectID, name string) (*managementv1.Role, error) {
+	// the name filter of the API is a partial match
+	roles, err := listRoles(ctx, client, projectID, &managementv1.ListRolesOptions{Name: &name})
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []*managementv1.Role
+	for _, r := range roles {
+		if r.Name == name {
+			matches = append(matches, r)
+		}
+	}
+
+	return uniqueMatch(matches, fmt.Sprintf("role named %q in project %s", name, projectID))
+}
+
+// findProjectByName returns the project with the given name, among the projects visible to the provider.
+func findProjectByName(ctx context.Context, client *lakekeeper.Client, name string) (*managementv1.Project, error) {
+	resp, _, err := client.ProjectV1().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []*managementv1.Project
+	for _, p := range resp.Projects {
+		if p.Name == name {
+			matches = append(matches, p)
+		}
+	}
+
+	return uniqueMatch(matches, fmt.Sprintf("project named %q", name))
+}
+
+// findUserByName returns the user with the given name.
+func findUserByName(ctx context.Context, client *lakekeeper.Client, name string) (*managementv1.User, error) {
+	// the name filter of the API is a partial match
+	users, err := listUsers(ctx, client, &managementv1.ListUsersOptions{Name: &name})
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []*managementv1.User
+	for _, u := range users {
+		if u.Name == name {
+			matches = append(matches, u)
+		}
+	}
+
+	return uniqueMatch(matches, fmt.Sprintf("user named %q", name))
+}
+
+// findUserByEmail returns the user with the given email, emails are case insensitive.
+func findUserByEmail(ctx context.Context, client *lakekeeper.Client, email string) (*managementv1.User, error) {
+	// the API cannot filter on emails
+	users, err := listUsers(ctx, client, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []*managementv1.User
+	for _, u := range users {
+		if u.Email != nil && strings.EqualFold(*u.Email, email) {
+			matches = append(matches, u)
+		}
+	}
+
+	return uniqueMatch(matches, fmt.Sprintf("user with email %q", email))
+}
+
+// uniqueMatch returns the only element of matches, an error otherwise.
+func uniqueMatch[T any](matches []*T, description string) (*T, error) {
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("found no %s", description)
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf("the lookup of the %s is ambiguous, %d matches found, use the ID instead", description, len(matches))
+	}
+}
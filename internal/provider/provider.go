@@ -3,21 +3,31 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"strconv"
 
+	"github.com/apache/iceberg-go/catalog/rest"
 	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
 
 	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/api"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure LakekeeperProvider satisfies various provider interfaces.
-var _ provider.Provider = &LakekeeperProvider{}
+var (
+	_ provider.Provider                  = &LakekeeperProvider{}
+	_ provider.ProviderWithListResources = &LakekeeperProvider{}
+)
 
 // LakekeeperProvider defines the provider implementation.
 type LakekeeperProvider struct {
@@ -37,6 +47,8 @@ type LakekeeperProviderModel struct {
 	CACertFile       types.String `tfsdk:"cacert_file"`
 	Insecure         types.Bool   `tfsdk:"insecure"`
 	InitialBootstrap types.Bool   `tfsdk:"initial_bootstrap"`
+	ReadOnly         types.Bool   `tfsdk:"read_only"`
+	MinServerVersion types.String `tfsdk:"minimum_server_version"`
 }
 
 type (
@@ -46,13 +58,15 @@ type (
 
 // Attributes passed into Datasources from the Provider
 type LakekeeperDatasourceData struct {
-	Client *lakekeeper.Client
+	Client     *lakekeeper.Client
+	HTTPClient *http.Client
 }
 
 // Attributes passed into Resources from the Provider
 type LakekeeperResourceData struct {
 	Client              *lakekeeper.Client
 	NewLakekeeperClient LakekeeperClientFactory
+	CatalogOptions      []rest.Option
 }
 
 func (p *LakekeeperProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -94,7 +108,19 @@ func (p *LakekeeperProvider) Schema(ctx context.Context, req provider.SchemaRequ
 				Optional:            true,
 			},
 			"initial_bootstrap": schema.BoolAttribute{
-				MarkdownDescription: "When set to true, the provider will try to bootstrap the server first. default: `true`.",
+				MarkdownDescription: "When set to true, the provider will try to bootstrap the server first. default: `false`. **Deprecated**: use the `lakekeeper_bootstrap` resource instead.",
+				Optional:            true,
+				DeprecationMessage:  "Bootstrapping the server as a side effect of the provider configuration is deprecated and will be removed in a future version. Use the `lakekeeper_bootstrap` resource instead.",
+			},
+			"minimum_server_version": schema.StringAttribute{
+				MarkdownDescription: "The minimum version of the Lakekeeper server, e.g. `0.10.0`. When set, the provider configuration fails if the server is older.",
+				Optional:            true,
+				Validators: []validator.String{
+					versionValidator{},
+				},
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "When set to true, the provider only sends read requests to Lakekeeper. Any resource creation, update or deletion fails, data sources keep working. It can also be set using the `LAKEKEEPER_READ_ONLY` environment variable. default: `false`.",
 				Optional:            true,
 			},
 		},
@@ -154,6 +180,15 @@ func (p *LakekeeperProvider) Configure(ctx context.Context, req provider.Configu
 		)
 	}
 
+	if config.ReadOnly.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("read_only"),
+			"Unknown read-only mode",
+			"The provider cannot create the Lakekeeper API client as there is an unknown configuration value for the read-only mode. "+
+				"Either apply the source of the value first, set the read_only attribute value statically in the configuration, or use the LAKEKEEPER_READ_ONLY environment variable.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -168,7 +203,20 @@ func (p *LakekeeperProvider) Configure(ctx context.Context, req provider.Configu
 			ClientSecret: os.Getenv("LAKEKEEPER_CLIENT_SECRET"),
 			Scopes:       []string{"lakekeeper"},
 		},
-		InitialBootstrap: true,
+		InitialBootstrap: false,
+	}
+
+	if v := os.Getenv("LAKEKEEPER_READ_ONLY"); v != "" {
+		readOnly, err := strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("read_only"),
+				"Invalid read-only mode",
+				fmt.Sprintf("The LAKEKEEPER_READ_ONLY environment variable must be a boolean, got %q.", v),
+			)
+			return
+		}
+		evaluatedConfig.ReadOnly = readOnly
 	}
 
 	if !config.Endpoint.IsNull() && !config.Endpoint.IsUnknown() {
@@ -206,6 +254,10 @@ func (p *LakekeeperProvider) Configure(ctx context.Context, req provider.Configu
 		evaluatedConfig.InitialBootstrap = config.InitialBootstrap.ValueBool()
 	}
 
+	if !config.ReadOnly.IsNull() && !config.ReadOnly.IsUnknown() {
+		evaluatedConfig.ReadOnly = config.ReadOnly.ValueBool()
+	}
+
 	clientFactory := newLakekeeperClient(evaluatedConfig, req.TerraformVersion, p.version)
 	lakekeeperClient, err := clientFactory(ctx)
 	if err != nil {
@@ -213,14 +265,32 @@ func (p *LakekeeperProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 
+	if !config.MinServerVersion.IsNull() && !config.MinServerVersion.IsUnknown() {
+		resp.Diagnostics.Append(checkMinimumServerVersion(ctx, lakekeeperClient, config.MinServerVersion.ValueString())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The Iceberg catalog client and the raw HTTP calls share the transport settings of the management client
+	transport, err := evaluatedConfig.NewTransport()
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create the HTTP transport from provider configuration", err.Error())
+		return
+	}
+
 	// Attach the client to the response so that it will be available for the Data Sources and Resources
 	resp.DataSourceData = &LakekeeperDatasourceData{
-		Client: lakekeeperClient,
+		Client:     lakekeeperClient,
+		HTTPClient: &http.Client{Transport: transport},
 	}
 	resp.ResourceData = &LakekeeperResourceData{
 		Client:              lakekeeperClient,
 		NewLakekeeperClient: clientFactory,
+		CatalogOptions:      []rest.Option{rest.WithCustomTransport(transport)},
 	}
+	// The list resources are implemented by the resources themselves
+	resp.ListResourceData = resp.ResourceData
 }
 
 func (p *LakekeeperProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -231,6 +301,10 @@ func (p *LakekeeperProvider) DataSources(ctx context.Context) []func() datasourc
 	return allDataSources
 }
 
+func (p *LakekeeperProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return allListResources
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &LakekeeperProvider{
@@ -257,3 +331,60 @@ func newLakekeeperClient(config api.Config, tfVersion, providerVersion string) L
 		return client, nil
 	}
 }
+
+// checkMinimumServerVersion ensures the Lakekeeper server is at least in the given version.
+func checkMinimumServerVersion(ctx context.Context, client *lakekeeper.Client, minimum string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	minVersion, err := version.NewVersion(minimum)
+	if err != nil {
+		diags.AddAttributeError(path.Root("minimum_server_version"), "Invalid minimum server version", err.Error())
+		return diags
+	}
+
+	info, _, err := client.ServerV1().Info(ctx)
+	if err != nil {
+		diags.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read server infos to check its version, %v", err))
+		return diags
+	}
+
+	serverVersion, err := version.NewVersion(info.Version)
+	if err != nil {
+		diags.AddWarning(
+			"Unable to check the Lakekeeper server version",
+			fmt.Sprintf("The server version %q can't be parsed, the minimum version %s is not enforced.", info.Version, minimum),
+		)
+		return diags
+	}
+
+	if serverVersion.LessThan(minVersion) {
+		diags.AddAttributeError(
+			path.Root("minimum_server_version"),
+			"Unsupported Lakekeeper server version",
+			fmt.Sprintf("The Lakekeeper server %s runs version %s, but at least version %s is required by the provider configuration. Upgrade the server or lower `minimum_server_version`.", info.ServerID, info.Version, minimum),
+		)
+	}
+
+	return diags
+}
+
+// versionValidator validates that a string is a valid version.
+type versionValidator struct{}
+
+func (v versionValidator) Description(ctx context.Context) string {
+	return "value must be a valid version, e.g. 0.10.0"
+}
+
+func (v versionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v versionValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := version.NewVersion(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid version", fmt.Sprintf("%s, got %q: %v", v.Description(ctx), req.ConfigValue.ValueString(), err))
+	}
+}
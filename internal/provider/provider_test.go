@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -117,3 +118,134 @@ func TestProvider_OIDCAuth(t *testing.T) {
 	})
 
 }
+
+func TestProvider_ReadOnly(t *testing.T) {
+	projectCreateCall := false
+	mockLakekeeperServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/token" && r.Method == "POST":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			// nolint - don't need to err check writing the response in the test
+			w.Write([]byte(`{"access_token": "SlAV32hkKG", "token_type": "Bearer", "expires_in": 3600}`))
+		case r.URL.Path == "/management/v1/info" && r.Method == "GET":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			// nolint - don't need to err check writing the response in the test
+			w.Write([]byte(`{
+				"version":"0.9.1",
+				"bootstrapped":true,
+				"server-id":"00000000-0000-0000-0000-000000000000",
+				"default-project-id":"00000000-0000-0000-0000-000000000000",
+				"authz-backend":"allow-all",
+				"aws-system-identities-enabled":false,
+				"azure-system-identities-enabled":false,
+				"gcp-system-identities-enabled":false,
+				"queues":["tabular_expiration","tabular_purge"]
+			}`))
+		case r.URL.Path == "/management/v1/project" && r.Method == "POST":
+			projectCreateCall = true
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer mockLakekeeperServer.Close()
+
+	providerConfig := fmt.Sprintf(`
+		provider "lakekeeper" {
+			endpoint = "%s"
+			auth_url = "%s/token"
+			client_id = "test-id"
+			client_secret = "test-secret"
+			read_only = true
+		}
+		`, mockLakekeeperServer.URL, mockLakekeeperServer.URL)
+
+	//lintignore:AT001 // Providers don't need check destroy in their tests
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				//lintignore:AT004 // Explicitly testing a provider configuration
+				Config: providerConfig + `data "lakekeeper_server_info" "test" {}`,
+				Check:  resource.TestCheckResourceAttr("data.lakekeeper_server_info.test", "bootstrapped", "true"),
+			},
+			{
+				//lintignore:AT004 // Explicitly testing a provider configuration
+				Config:      providerConfig + `resource "lakekeeper_project" "test" { name = "read-only" }`,
+				ExpectError: regexp.MustCompile(`read-only mode, refusing to send POST /management/v1/project`),
+			},
+		},
+	})
+
+	if projectCreateCall {
+		t.Fatal("expected no project creation request")
+	}
+}
+
+func TestProvider_MinimumServerVersion(t *testing.T) {
+	mockLakekeeperServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/token" && r.Method == "POST":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			// nolint - don't need to err check writing the response in the test
+			w.Write([]byte(`{"access_token": "SlAV32hkKG", "token_type": "Bearer", "expires_in": 3600}`))
+		case r.URL.Path == "/management/v1/info" && r.Method == "GET":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			// nolint - don't need to err check writing the response in the test
+			w.Write([]byte(`{
+				"version":"0.9.1",
+				"bootstrapped":true,
+				"server-id":"00000000-0000-0000-0000-000000000000",
+				"default-project-id":"00000000-0000-0000-0000-000000000000",
+				"authz-backend":"allow-all",
+				"aws-system-identities-enabled":false,
+				"azure-system-identities-enabled":false,
+				"gcp-system-identities-enabled":false,
+				"queues":["tabular_expiration","tabular_purge"]
+			}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer mockLakekeeperServer.Close()
+
+	providerConfig := func(minimum string) string {
+		return fmt.Sprintf(`
+		provider "lakekeeper" {
+			endpoint = "%s"
+			auth_url = "%s/token"
+			client_id = "test-id"
+			client_secret = "test-secret"
+			minimum_server_version = "%s"
+		}
+
+		data "lakekeeper_server_info" "test" {}
+		`, mockLakekeeperServer.URL, mockLakekeeperServer.URL, minimum)
+	}
+
+	//lintignore:AT001 // Providers don't need check destroy in their tests
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				//lintignore:AT004 // Explicitly testing a provider configuration
+				Config:      providerConfig("0.10.0"),
+				ExpectError: regexp.MustCompile(`Unsupported Lakekeeper server version`),
+			},
+			{
+				//lintignore:AT004 // Explicitly testing a provider configuration
+				Config:      providerConfig("not-a-version"),
+				ExpectError: regexp.MustCompile(`Invalid version`),
+			},
+			{
+				//lintignore:AT004 // Explicitly testing a provider configuration
+				Config: providerConfig("0.9.0"),
+				Check:  resource.TestCheckResourceAttr("data.lakekeeper_server_info.test", "version", "0.9.1"),
+			},
+		},
+	})
+}
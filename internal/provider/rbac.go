@@ -0,0 +1,442 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	managementv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1"
+	permissionv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1/permission"
+	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
+)
+
+// rbacSnapshotVersion is the version of the RBAC snapshot document, it changes
+// when the document is modified in a way older providers cannot apply.
+const rbacSnapshotVersion = 1
+
+// rbacSnapshot is the document describing the roles and the assignments of a project.
+// The roles and the warehouses are referenced by their name, so that the document can
+// be applied to another Lakekeeper, the users are referenced by their ID which is the
+// subject given by the identity provider.
+type rbacSnapshot struct {
+	Version              int                       `json:"version"`
+	Roles                []rbacRole                `json:"roles"`
+	Users                []rbacUser                `json:"users"`
+	RoleAssignments      []rbacRoleAssignment      `json:"role_assignments"`
+	ProjectAssignments   []rbacAssignment          `json:"project_assignments"`
+	WarehouseAssignments []rbacWarehouseAssignment `json:"warehouse_assignments"`
+}
+
+type rbacRole struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+type rbacUser struct {
+	ID       string  `json:"id"`
+	Name     string  `json:"name"`
+	Email    *string `json:"email,omitempty"`
+	UserType string  `json:"user_type"`
+}
+
+// rbacAssignee is a user, by its ID, or a role, by its name.
+type rbacAssignee struct {
+	Type string `json:"type"`
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+type rbacAssignment struct {
+	Assignee   rbacAssignee `json:"assignee"`
+	Assignment string       `json:"assignment"`
+}
+
+type rbacRoleAssignment struct {
+	Role string `json:"role"`
+	rbacAssignment
+}
+
+type rbacWarehouseAssignment struct {
+	Warehouse string `json:"warehouse"`
+	rbacAssignment
+}
+
+// parseRBACSnapshot decodes an RBAC snapshot document.
+func parseRBACSnapshot(s string) (*rbacSnapshot, error) {
+	var snapshot rbacSnapshot
+	if err := json.Unmarshal([]byte(s), &snapshot); err != nil {
+		return nil, fmt.Errorf("invalid RBAC snapshot, %w", err)
+	}
+
+	if snapshot.Version != rbacSnapshotVersion {
+		return nil, fmt.Errorf("unsupported RBAC snapshot version %d, expected %d", snapshot.Version, rbacSnapshotVersion)
+	}
+
+	roles := make(map[string]bool, len(snapshot.Roles))
+	for _, r := range snapshot.Roles {
+		roles[r.Name] = true
+	}
+	for _, a := range snapshot.RoleAssignments {
+		if !roles[a.Role] {
+			return nil, fmt.Errorf("invalid RBAC snapshot, the role %q has assignments but is not part of the roles", a.Role)
+		}
+	}
+
+	for _, a := range snapshot.assignments() {
+		switch a.Type {
+		case string(permissionv1.UserType):
+			if a.ID == "" {
+				return nil, fmt.Errorf("invalid RBAC snapshot, a user assignee has no id")
+			}
+		case string(permissionv1.RoleType):
+			if !roles[a.Name] {
+				return nil, fmt.Errorf("invalid RBAC snapshot, the role %q is assigned but is not part of the roles", a.Name)
+			}
+		default:
+			return nil, fmt.Errorf("invalid RBAC snapshot, unknown assignee type %q", a.Type)
+		}
+	}
+
+	return &snapshot, nil
+}
+
+// JSON encodes the snapshot, the output is stable as long as the RBAC of the project does not change.
+func (s *rbacSnapshot) JSON() (string, error) {
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// assignments returns the assignees of all the assignments of the snapshot.
+func (s *rbacSnapshot) assignments() []rbacAssignee {
+	var assignees []rbacAssignee
+	for _, a := range s.RoleAssignments {
+		assignees = append(assignees, a.Assignee)
+	}
+	for _, a := range s.ProjectAssignments {
+		assignees = append(assignees, a.Assignee)
+	}
+	for _, a := range s.WarehouseAssignments {
+		assignees = append(assignees, a.Assignee)
+	}
+	return assignees
+}
+
+// includes returns whether the roles and the assignments of other are all part of s.
+// The users are compared through the assignments only, as their name and email are
+// maintained by the identity provider.
+func (s *rbacSnapshot) includes(other *rbacSnapshot) bool {
+	return isSubset(other.Roles, s.Roles) &&
+		isSubset(other.RoleAssignments, s.RoleAssignments) &&
+		isSubset(other.ProjectAssignments, s.ProjectAssignments) &&
+		isSubset(other.WarehouseAssignments, s.WarehouseAssignments)
+}
+
+// isSubset returns whether all the elements of a are in b.
+func isSubset[T comparable](a, b []T) bool {
+	for _, v := range a {
+		if !slices.Contains(b, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// difference returns the elements of a which are not in b.
+func difference[T comparable](a, b []T) []T {
+	var diff []T
+	for _, v := range a {
+		if !slices.Contains(b, v) {
+			diff = append(diff, v)
+		}
+	}
+	return diff
+}
+
+// readRBACSnapshot builds the RBAC snapshot of a project.
+func readRBACSnapshot(ctx context.Context, client *lakekeeper.Client, projectID string) (*rbacSnapshot, error) {
+	snapshot := rbacSnapshot{
+		Version:              rbacSnapshotVersion,
+		Roles:                []rbacRole{},
+		Users:                []rbacUser{},
+		RoleAssignments:      []rbacRoleAssignment{},
+		ProjectAssignments:   []rbacAssignment{},
+		WarehouseAssignments: []rbacWarehouseAssignment{},
+	}
+
+	roles, err := listRoles(ctx, client, projectID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list the roles of project %s, %w", projectID, err)
+	}
+
+	roleNames := make(map[string]string, len(roles))
+	for _, r := range roles {
+		roleNames[r.ID] = r.Name
+		role := rbacRole{Name: r.Name}
+		if r.Description != nil {
+			role.Description = *r.Description
+		}
+		snapshot.Roles = append(snapshot.Roles, role)
+	}
+
+	userIDs := make(map[string]bool)
+	assignment := func(a permissionv1.Assignment) (rbacAssignment, error) {
+		assignee := rbacAssignee{Type: string(a.GetPrincipalType())}
+		switch a.GetPrincipalType() {
+		case permissionv1.UserType:
+			assignee.ID = a.GetPrincipalID()
+			userIDs[assignee.ID] = true
+		case permissionv1.RoleType:
+			name, ok := roleNames[a.GetPrincipalID()]
+			if !ok {
+				return rbacAssignment{}, fmt.Errorf("the role %s is not part of project %s", a.GetPrincipalID(), projectID)
+			}
+			assignee.Name = name
+		}
+		return rbacAssignment{Assignee: assignee, Assignment: a.GetAssignment()}, nil
+	}
+
+	projectAssignments, _, err := client.PermissionV1().ProjectPermission().GetAssignments(ctx, projectID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the assignments of project %s, %w", projectID, err)
+	}
+	for _, a := range projectAssignments.Assignments {
+		v, err := assignment(a)
+		if err != nil {
+			return nil, err
+		}
+		snapshot.ProjectAssignments = append(snapshot.ProjectAssignments, v)
+	}
+
+	for _, r := range roles {
+		roleAssignments, _, err := client.PermissionV1().RolePermission().GetAssignments(ctx, r.ID, nil)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the assignments of role %s, %w", r.Name, err)
+		}
+		for _, a := range roleAssignments.Assignments {
+			v, err := assignment(a)
+			if err != nil {
+				return nil, err
+			}
+			snapshot.RoleAssignments = append(snapshot.RoleAssignments, rbacRoleAssignment{Role: r.Name, rbacAssignment: v})
+		}
+	}
+
+	warehouses, _, err := client.WarehouseV1(projectID).List(ctx, &managementv1.ListWarehouseOptions{
+		WarehouseStatus: []managementv1.WarehouseStatus{managementv1.WarehouseStatusActive, managementv1.WarehouseStatusInactive},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list the warehouses of project %s, %w", projectID, err)
+	}
+	for _, w := range warehouses.Warehouses {
+		warehouseAssignments, _, err := client.PermissionV1().WarehousePermission().GetAssignments(ctx, w.ID, nil)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the assignments of warehouse %s, %w", w.Name, err)
+		}
+		for _, a := range warehouseAssignments.Assignments {
+			v, err := assignment(a)
+			if err != nil {
+				return nil, err
+			}
+			snapshot.WarehouseAssignments = append(snapshot.WarehouseAssignments, rbacWarehouseAssignment{Warehouse: w.Name, rbacAssignment: v})
+		}
+	}
+
+	for id := range userIDs {
+		u, _, err := client.UserV1().Get(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read user %s, %w", id, err)
+		}
+		snapshot.Users = append(snapshot.Users, rbacUser{ID: u.ID, Name: u.Name, Email: u.Email, UserType: string(u.UserType)})
+	}
+
+	snapshot.sort()
+
+	return &snapshot, nil
+}
+
+// sort orders the snapshot, so that its encoding does not depend on the order returned by the API.
+func (s *rbacSnapshot) sort() {
+	compareAssignments := func(a, b rbacAssignment) int {
+		return cmp.Or(
+			cmp.Compare(a.Assignee.Type, b.Assignee.Type),
+			cmp.Compare(a.Assignee.ID, b.Assignee.ID),
+			cmp.Compare(a.Assignee.Name, b.Assignee.Name),
+			cmp.Compare(a.Assignment, b.Assignment),
+		)
+	}
+
+	slices.SortFunc(s.Roles, func(a, b rbacRole) int { return cmp.Compare(a.Name, b.Name) })
+	slices.SortFunc(s.Users, func(a, b rbacUser) int { return cmp.Compare(a.ID, b.ID) })
+	slices.SortFunc(s.RoleAssignments, func(a, b rbacRoleAssignment) int {
+		return cmp.Or(cmp.Compare(a.Role, b.Role), compareAssignments(a.rbacAssignment, b.rbacAssignment))
+	})
+	slices.SortFunc(s.ProjectAssignments, compareAssignments)
+	slices.SortFunc(s.WarehouseAssignments, func(a, b rbacWarehouseAssignment) int {
+		return cmp.Or(cmp.Compare(a.Warehouse, b.Warehouse), compareAssignments(a.rbacAssignment, b.rbacAssignment))
+	})
+}
+
+// applyRBACSnapshot reconciles the roles and the assignments of a project with the snapshot.
+// The missing users and roles are created and the missing assignments are written, with
+// prune the roles and the assignments which are not part of the snapshot are also deleted.
+// The warehouses are not managed, the ones referenced by the snapshot must exist.
+func applyRBACSnapshot(ctx context.Context, client *lakekeeper.Client, projectID string, desired *rbacSnapshot, prune bool) error {
+	for _, u := range desired.Users {
+		_, _, err := client.UserV1().Get(ctx, u.ID)
+		if err == nil {
+			continue
+		}
+		if !isNotFoundError(err) {
+			return fmt.Errorf("unable to read user %s, %w", u.ID, err)
+		}
+
+		userType := managementv1.UserType(u.UserType)
+		if _, _, err := client.UserV1().Provision(ctx, &managementv1.ProvisionUserOptions{
+			ID:       &u.ID,
+			Name:     &u.Name,
+			Email:    u.Email,
+			UserType: &userType,
+		}); err != nil {
+			return fmt.Errorf("unable to create user %s, %w", u.ID, err)
+		}
+	}
+
+	roles, err := listRoles(ctx, client, projectID, nil)
+	if err != nil {
+		return fmt.Errorf("unable to list the roles of project %s, %w", projectID, err)
+	}
+
+	existing := make(map[string]*managementv1.Role, len(roles))
+	for _, r := range roles {
+		if slices.ContainsFunc(desired.Roles, func(d rbacRole) bool { return d.Name == r.Name }) {
+			existing[r.Name] = r
+			continue
+		}
+		if prune {
+			if _, err := client.RoleV1(projectID).Delete(ctx, r.ID); err != nil {
+				return fmt.Errorf("unable to delete role %s, %w", r.Name, err)
+			}
+		}
+	}
+
+	roleIDs := make(map[string]string, len(desired.Roles))
+	for _, d := range desired.Roles {
+		var description *string
+		if d.Description != "" {
+			description = &d.Description
+		}
+
+		r, ok := existing[d.Name]
+		if !ok {
+			role, _, err := client.RoleV1(projectID).Create(ctx, &managementv1.CreateRoleOptions{Name: d.Name, Description: description})
+			if err != nil {
+				return fmt.Errorf("unable to create role %s, %w", d.Name, err)
+			}
+			roleIDs[d.Name] = role.ID
+			continue
+		}
+
+		roleIDs[d.Name] = r.ID
+		if (r.Description == nil && description != nil) || (r.Description != nil && *r.Description != d.Description) {
+			if _, _, err := client.RoleV1(projectID).Update(ctx, r.ID, &managementv1.UpdateRoleOptions{Name: d.Name, Description: description}); err != nil {
+				return fmt.Errorf("unable to update role %s, %w", d.Name, err)
+			}
+		}
+	}
+
+	warehouses, _, err := client.WarehouseV1(projectID).List(ctx, &managementv1.ListWarehouseOptions{
+		WarehouseStatus: []managementv1.WarehouseStatus{managementv1.WarehouseStatusActive, managementv1.WarehouseStatusInactive},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list the warehouses of project %s, %w", projectID, err)
+	}
+
+	warehouseIDs := make(map[string]string, len(warehouses.Warehouses))
+	for _, w := range warehouses.Warehouses {
+		warehouseIDs[w.Name] = w.ID
+	}
+	for _, a := range desired.WarehouseAssignments {
+		if _, ok := warehouseIDs[a.Warehouse]; !ok {
+			return fmt.Errorf("the warehouse %s does not exist in project %s", a.Warehouse, projectID)
+		}
+	}
+
+	current, err := readRBACSnapshot(ctx, client, projectID)
+	if err != nil {
+		return err
+	}
+
+	userOrRole := func(a rbacAssignee) permissionv1.UserOrRole {
+		if a.Type == string(permissionv1.RoleType) {
+			return permissionv1.UserOrRole{Type: permissionv1.RoleType, Value: roleIDs[a.Name]}
+		}
+		return permissionv1.UserOrRole{Type: permissionv1.UserType, Value: a.ID}
+	}
+
+	// project assignments
+	var projectOpts permissionv1.UpdateProjectPermissionsOptions
+	for _, a := range difference(desired.ProjectAssignments, current.ProjectAssignments) {
+		projectOpts.Writes = append(projectOpts.Writes, &permissionv1.ProjectAssignment{Assignee: userOrRole(a.Assignee), Assignment: permissionv1.ProjectAssignmentType(a.Assignment)})
+	}
+	if prune {
+		for _, a := range difference(current.ProjectAssignments, desired.ProjectAssignments) {
+			projectOpts.Deletes = append(projectOpts.Deletes, &permissionv1.ProjectAssignment{Assignee: userOrRole(a.Assignee), Assignment: permissionv1.ProjectAssignmentType(a.Assignment)})
+		}
+	}
+	if len(projectOpts.Writes) > 0 || len(projectOpts.Deletes) > 0 {
+		if _, err := client.PermissionV1().ProjectPermission().Update(ctx, projectID, &projectOpts); err != nil {
+			return fmt.Errorf("unable to update the assignments of project %s, %w", projectID, err)
+		}
+	}
+
+	// role assignments
+	roleOpts := make(map[string]*permissionv1.UpdateRolePermissionsOptions)
+	for _, a := range difference(desired.RoleAssignments, current.RoleAssignments) {
+		if roleOpts[a.Role] == nil {
+			roleOpts[a.Role] = &permissionv1.UpdateRolePermissionsOptions{}
+		}
+		roleOpts[a.Role].Writes = append(roleOpts[a.Role].Writes, &permissionv1.RoleAssignment{Assignee: userOrRole(a.Assignee), Assignment: permissionv1.RoleAssignmentType(a.Assignment)})
+	}
+	if prune {
+		for _, a := range difference(current.RoleAssignments, desired.RoleAssignments) {
+			if roleOpts[a.Role] == nil {
+				roleOpts[a.Role] = &permissionv1.UpdateRolePermissionsOptions{}
+			}
+			roleOpts[a.Role].Deletes = append(roleOpts[a.Role].Deletes, &permissionv1.RoleAssignment{Assignee: userOrRole(a.Assignee), Assignment: permissionv1.RoleAssignmentType(a.Assignment)})
+		}
+	}
+	for role, opts := range roleOpts {
+		if _, err := client.PermissionV1().RolePermission().Update(ctx, roleIDs[role], opts); err != nil {
+			return fmt.Errorf("unable to update the assignments of role %s, %w", role, err)
+		}
+	}
+
+	// warehouse assignments
+	warehouseOpts := make(map[string]*permissionv1.UpdateWarehousePermissionsOptions)
+	for _, a := range difference(desired.WarehouseAssignments, current.WarehouseAssignments) {
+		if warehouseOpts[a.Warehouse] == nil {
+			warehouseOpts[a.Warehouse] = &permissionv1.UpdateWarehousePermissionsOptions{}
+		}
+		warehouseOpts[a.Warehouse].Writes = append(warehouseOpts[a.Warehouse].Writes, &permissionv1.WarehouseAssignment{Assignee: userOrRole(a.Assignee), Assignment: permissionv1.WarehouseAssignmentType(a.Assignment)})
+	}
+	if prune {
+		for _, a := range difference(current.WarehouseAssignments, desired.WarehouseAssignments) {
+			if warehouseOpts[a.Warehouse] == nil {
+				warehouseOpts[a.Warehouse] = &permissionv1.UpdateWarehousePermissionsOptions{}
+			}
+			warehouseOpts[a.Warehouse].Deletes = append(warehouseOpts[a.Warehouse].Deletes, &permissionv1.WarehouseAssignment{Assignee: userOrRole(a.Assignee), Assignment: permissionv1.WarehouseAssignmentType(a.Assignment)})
+		}
+	}
+	for warehouse, opts := range warehouseOpts {
+		if _, err := client.PermissionV1().WarehousePermission().Update(ctx, warehouseIDs[warehouse], opts); err != nil {
+			return fmt.Errorf("unable to update the assignments of warehouse %s, %w", warehouse, err)
+		}
+	}
+
+	return nil
+}
@@ -1,16 +1,20 @@
 package provider
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
-	allDataSources []func() datasource.DataSource
-	allResources   []func() resource.Resource
+	allDataSources   []func() datasource.DataSource
+	allResources     []func() resource.Resource
+	allListResources []func() list.ListResource
 )
 
 // registerDataSource may be called during package initialization to register a new data source with the provider.
@@ -23,9 +27,24 @@ func registerResource(fn func() resource.Resource) {
 	allResources = append(allResources, fn)
 }
 
-func splitInternalID(s types.String) (string, string) {
-	splitted := strings.Split(s.ValueString(), "/")
-	return splitted[0], splitted[1]
+// registerListResource may be called during package initialization to register a new list resource with the provider.
+func registerListResource(fn func() list.ListResource) {
+	allListResources = append(allListResources, fn)
+}
+
+// splitInternalID splits an internal ID of the form `{{first}}/{{second}}`.
+func splitInternalID(s types.String) (string, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	first, second, found := strings.Cut(s.ValueString(), "/")
+	if !found || first == "" || second == "" || strings.Contains(second, "/") {
+		diags.AddError(
+			"Invalid resource ID",
+			fmt.Sprintf("Expected an ID of the form first_id/second_id, got: %q", s.ValueString()),
+		)
+	}
+
+	return first, second, diags
 }
 
 func DiffTypedStrings(oldList, newList []types.String) (added, removed []types.String) {
@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	managementv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1"
+	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ resource.Resource              = &lakekeeperBootstrapResource{}
+	_ resource.ResourceWithConfigure = &lakekeeperBootstrapResource{}
+)
+
+func init() {
+	registerResource(NewLakekeeperBootstrapResource)
+}
+
+// NewLakekeeperBootstrapResource is a helper function to simplify the provider implementation.
+func NewLakekeeperBootstrapResource() resource.Resource {
+	return &lakekeeperBootstrapResource{}
+}
+
+func (r *lakekeeperBootstrapResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bootstrap"
+}
+
+// lakekeeperBootstrapResource defines the resource implementation.
+type lakekeeperBootstrapResource struct {
+	client *lakekeeper.Client
+}
+
+// lakekeeperBootstrapResourceModel describes the resource data model.
+type lakekeeperBootstrapResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	AcceptTermsOfUse types.Bool   `tfsdk:"accept_terms_of_use"`
+	IsOperator       types.Bool   `tfsdk:"is_operator"`
+	UserType         types.String `tfsdk:"user_type"`
+	Bootstrapped     types.Bool   `tfsdk:"bootstrapped"`
+	ServerID         types.String `tfsdk:"server_id"`
+}
+
+func (r *lakekeeperBootstrapResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`lakekeeper_bootstrap`" + ` resource bootstraps a Lakekeeper server. The identity used by the provider becomes the initial administrator of the server.
+
+Bootstrapping can only happen once per server. If the server is already bootstrapped, the resource only records it in the state. Destroying this resource does not revert the bootstrap.
+
+**Upstream API**: [Lakekeeper REST API docs](https://docs.lakekeeper.io/docs/nightly/api/management/#tag/server/operation/bootstrap)`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource. It is the ID of the bootstrapped server.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"accept_terms_of_use": schema.BoolAttribute{
+				MarkdownDescription: "Accept the Lakekeeper terms of use. Must be `true`.",
+				Required:            true,
+				Validators: []validator.Bool{
+					boolvalidator.Equals(true),
+				},
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_operator": schema.BoolAttribute{
+				MarkdownDescription: "Whether the bootstrapping identity is granted the `operator` role on the server. Default is `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_type": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The type of the bootstrapping user, must be `%s` or `%s`. Default is `%s`.", managementv1.HumanUserType, managementv1.ApplicationUserType, managementv1.ApplicationUserType),
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(managementv1.ApplicationUserType)),
+				Validators:          []validator.String{stringvalidator.OneOf(string(managementv1.HumanUserType), string(managementv1.ApplicationUserType))},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bootstrapped": schema.BoolAttribute{
+				MarkdownDescription: "True if the server has been bootstrapped. This reflects the `bootstrapped` field of the server info.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the bootstrapped server.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *lakekeeperBootstrapResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceData := req.ProviderData.(*LakekeeperResourceData)
+	r.client = resourceData.Client
+}
+
+// Create bootstraps the server and adds it into the Terraform state.
+func (r *lakekeeperBootstrapResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state lakekeeperBootstrapResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	info, _, err := r.client.ServerV1().Info(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read server infos, %v", err))
+		return
+	}
+
+	if info.Bootstrapped {
+		tflog.Warn(ctx, "server is already bootstrapped, skipping", map[string]any{
+			"server_id": info.ServerID,
+		})
+	} else {
+		userType := managementv1.UserType(state.UserType.ValueString())
+
+		opts := managementv1.BootstrapServerOptions{
+			AcceptTermsOfUse: state.AcceptTermsOfUse.ValueBool(),
+			IsOperator:       state.IsOperator.ValueBoolPointer(),
+			UserType:         &userType,
+		}
+
+		if _, err := r.client.ServerV1().Bootstrap(ctx, &opts); err != nil {
+			resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to bootstrap server %s, %v", info.ServerID, err))
+			return
+		}
+
+		info, _, err = r.client.ServerV1().Info(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read server infos, %v", err))
+			return
+		}
+
+		tflog.Debug(ctx, "bootstrapped the server", map[string]any{
+			"server_id": info.ServerID,
+		})
+	}
+
+	state.ID = types.StringValue(info.ServerID)
+	state.ServerID = types.StringValue(info.ServerID)
+	state.Bootstrapped = types.BoolValue(info.Bootstrapped)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *lakekeeperBootstrapResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state lakekeeperBootstrapResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	info, _, err := r.client.ServerV1().Info(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read server infos, %v", err))
+		return
+	}
+
+	// The provider now targets a server which is not bootstrapped or which
+	// is not the one recorded in the state, the bootstrap must be planned again.
+	if !info.Bootstrapped || info.ServerID != state.ServerID.ValueString() {
+		tflog.Warn(ctx, "server is not bootstrapped anymore, removing from state", map[string]any{
+			"server_id":          info.ServerID,
+			"previous_server_id": state.ServerID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(info.ServerID)
+	state.ServerID = types.StringValue(info.ServerID)
+	state.Bootstrapped = types.BoolValue(info.Bootstrapped)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called, every configurable attribute requires a replacement.
+func (r *lakekeeperBootstrapResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan lakekeeperBootstrapResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the resource from the state, the server stays bootstrapped.
+func (r *lakekeeperBootstrapResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state lakekeeperBootstrapResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Warn(ctx, "a server bootstrap can't be reverted, only removing it from state", map[string]any{
+		"server_id": state.ServerID.ValueString(),
+	})
+
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,134 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/testutil"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccLakekeeperBootstrap_alreadyBootstrapped(t *testing.T) {
+	server, _, err := testutil.TestLakekeeperClient.ServerV1().Info(t.Context())
+	if err != nil {
+		t.Fatalf("could not get server info, %s", err.Error())
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "lakekeeper_bootstrap" "this" {
+					accept_terms_of_use = true
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakekeeper_bootstrap.this", "id", server.ServerID),
+					resource.TestCheckResourceAttr("lakekeeper_bootstrap.this", "server_id", server.ServerID),
+					resource.TestCheckResourceAttr("lakekeeper_bootstrap.this", "bootstrapped", "true"),
+					resource.TestCheckResourceAttr("lakekeeper_bootstrap.this", "is_operator", "false"),
+					resource.TestCheckResourceAttr("lakekeeper_bootstrap.this", "user_type", "application"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLakekeeperBootstrap_termsOfUse(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "lakekeeper_bootstrap" "this" {
+					accept_terms_of_use = false
+				}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
+
+func TestAccLakekeeperBootstrap_mock(t *testing.T) {
+	bootstrapped := false
+	var bootstrapBody map[string]any
+
+	mockLakekeeperServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/token" && r.Method == "POST":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			// nolint - don't need to err check writing the response in the test
+			w.Write([]byte(`{"access_token": "SlAV32hkKG", "token_type": "Bearer", "expires_in": 3600}`))
+		case r.URL.Path == "/management/v1/bootstrap" && r.Method == "POST":
+			if err := json.NewDecoder(r.Body).Decode(&bootstrapBody); err != nil {
+				t.Errorf("could not decode bootstrap request, %v", err)
+			}
+			bootstrapped = true
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/management/v1/info" && r.Method == "GET":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			// nolint - don't need to err check writing the response in the test
+			w.Write(fmt.Appendf(nil, `{
+				"version":"0.9.1",
+				"bootstrapped":%t,
+				"server-id":"00000000-0000-0000-0000-000000000001",
+				"default-project-id":"00000000-0000-0000-0000-000000000000",
+				"authz-backend":"allow-all",
+				"aws-system-identities-enabled":false,
+				"azure-system-identities-enabled":false,
+				"gcp-system-identities-enabled":false,
+				"queues":[]
+			}`, bootstrapped))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer mockLakekeeperServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				//lintignore:AT004 // Explicitly testing a provider configuration
+				Config: fmt.Sprintf(`
+				provider "lakekeeper" {
+					endpoint = "%s"
+					auth_url = "%s/token"
+					client_id = "test-id"
+					client_secret = "test-secret"
+				}
+
+				resource "lakekeeper_bootstrap" "this" {
+					accept_terms_of_use = true
+					is_operator = true
+					user_type = "human"
+				}
+				`, mockLakekeeperServer.URL, mockLakekeeperServer.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakekeeper_bootstrap.this", "server_id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("lakekeeper_bootstrap.this", "bootstrapped", "true"),
+					func(*terraform.State) error {
+						if bootstrapBody == nil {
+							return fmt.Errorf("expected a bootstrap request")
+						}
+						if bootstrapBody["accept-terms-of-use"] != true || bootstrapBody["is-operator"] != true || bootstrapBody["user-type"] != "human" {
+							return fmt.Errorf("unexpected bootstrap request, got %v", bootstrapBody)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -5,16 +5,22 @@ import (
 	"fmt"
 	"strings"
 
+	managementv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1"
 	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/api"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/apache/iceberg-go/catalog"
+	"github.com/apache/iceberg-go/catalog/rest"
+	"github.com/apache/iceberg-go/table"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
@@ -22,10 +28,20 @@ var (
 	_ resource.Resource                = &lakekeeperNamespaceResource{}
 	_ resource.ResourceWithConfigure   = &lakekeeperNamespaceResource{}
 	_ resource.ResourceWithImportState = &lakekeeperNamespaceResource{}
+	_ resource.ResourceWithIdentity    = &lakekeeperNamespaceResource{}
+	_ list.ListResourceWithConfigure   = &lakekeeperNamespaceResource{}
 )
 
+// namespaceIdentity is the identity of the resource, the import ID is `{{project_id}}/{{warehouse_name}}/{{name}}`.
+var namespaceIdentity = resourceIdentity{
+	{Name: "project_id", Description: "The ID of the project where the namespace is located."},
+	{Name: "warehouse_name", Description: "The name of the warehouse where the namespace is located."},
+	{Name: "name", Description: "The name of the namespace."},
+}
+
 func init() {
 	registerResource(NewLakekeeperNamespaceResource)
+	registerListResource(NewLakekeeperNamespaceListResource)
 }
 
 // NewLakekeeperNamespaceResource is a helper function to simplify the provider implementation.
@@ -33,21 +49,33 @@ func NewLakekeeperNamespaceResource() resource.Resource {
 	return &lakekeeperNamespaceResource{}
 }
 
+// NewLakekeeperNamespaceListResource is a helper function to simplify the provider implementation.
+func NewLakekeeperNamespaceListResource() list.ListResource {
+	return &lakekeeperNamespaceResource{}
+}
+
 func (r *lakekeeperNamespaceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_namespace"
 }
 
 // lakekeeperNamespaceResource defines the resource implementation.
 type lakekeeperNamespaceResource struct {
-	client *lakekeeper.Client
+	client         *lakekeeper.Client
+	catalogOptions []rest.Option
 }
 
 // lakekeeperNamespaceResourceModel describes the resource data model.
 type lakekeeperNamespaceResourceModel struct {
-	ID            types.String `tfsdk:"id"`
-	ProjectID     types.String `tfsdk:"project_id"`
-	WarehouseName types.String `tfsdk:"warehouse_name"`
-	Name          types.String `tfsdk:"name"`
+	ID              types.String `tfsdk:"id"`
+	ProjectID       types.String `tfsdk:"project_id"`
+	WarehouseName   types.String `tfsdk:"warehouse_name"`
+	Name            types.String `tfsdk:"name"`
+	WarehouseID     types.String `tfsdk:"warehouse_id"`
+	NamespaceID     types.String `tfsdk:"namespace_id"`
+	Protected       types.Bool   `tfsdk:"protected"`
+	ForceDestroy    types.Bool   `tfsdk:"force_destroy"`
+	RecursiveDelete types.Bool   `tfsdk:"recursive_delete"`
+	Purge           types.Bool   `tfsdk:"purge"`
 }
 
 func (r *lakekeeperNamespaceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -67,10 +95,16 @@ func (r *lakekeeperNamespaceResource) Schema(ctx context.Context, req resource.S
 			"project_id": schema.StringAttribute{
 				MarkdownDescription: "The internal ID of the project where the namespace is located.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"warehouse_name": schema.StringAttribute{
 				MarkdownDescription: "The name of the warehouse where the namespace is located.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The name of the namespace.",
@@ -79,6 +113,44 @@ func (r *lakekeeperNamespaceResource) Schema(ctx context.Context, req resource.S
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"warehouse_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the warehouse where the namespace is located.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"namespace_id": schema.StringAttribute{
+				MarkdownDescription: "The internal ID of the namespace.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"protected": schema.BoolAttribute{
+				MarkdownDescription: "Whether the namespace is protected from being dropped, by Terraform and by any engine. Default is `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"force_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether the namespace is dropped even when it is protected or not empty, along with its tables, views and nested namespaces. Otherwise the destroy fails when the namespace is not empty. Default is `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"recursive_delete": schema.BoolAttribute{
+				MarkdownDescription: "Whether the tables, views and nested namespaces of the namespace are dropped before the namespace. Unlike `force_destroy`, the destroy still fails when the namespace or its content is protected. Default is `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"purge": schema.BoolAttribute{
+				MarkdownDescription: "Whether the data of the tables dropped along with the namespace is purged. Otherwise only the tables are dropped from the catalog and their data is left in the storage. Only used when `recursive_delete` or `force_destroy` is `true`. Default is `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -92,6 +164,7 @@ func (r *lakekeeperNamespaceResource) Configure(ctx context.Context, req resourc
 
 	resourceData := req.ProviderData.(*LakekeeperResourceData)
 	r.client = resourceData.Client
+	r.catalogOptions = resourceData.CatalogOptions
 }
 
 // Create creates a new upstream resources and adds it into the Terraform state.
@@ -108,7 +181,7 @@ func (r *lakekeeperNamespaceResource) Create(ctx context.Context, req resource.C
 	project_id := state.ProjectID.ValueString()
 	warehouse_name := state.WarehouseName.ValueString()
 
-	cat, err := r.client.CatalogV1(ctx, project_id, warehouse_name)
+	cat, err := r.client.CatalogV1(ctx, project_id, warehouse_name, r.catalogOptions...)
 	if err != nil {
 		resp.Diagnostics.AddError("Could not create the Iceberg Catalog client", fmt.Sprintf("Unable to initialize the client, %v", err.Error()))
 		return
@@ -129,7 +202,29 @@ func (r *lakekeeperNamespaceResource) Create(ctx context.Context, req resource.C
 		"id": state.ID.ValueString(),
 	})
 
+	// the namespace exists from now on, it is saved in the state even if the next steps fail
+	protected := state.Protected.ValueBool()
+	state.Protected = types.BoolValue(false)
+
+	if err := r.resolveIDs(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read the ID of namespace %s, %v", name, err))
+		// the IDs which could not be read are resolved again by the next refresh
+		if state.WarehouseID.IsUnknown() {
+			state.WarehouseID = types.StringNull()
+		}
+		if state.NamespaceID.IsUnknown() {
+			state.NamespaceID = types.StringNull()
+		}
+	} else if protected {
+		if err := r.setProtection(ctx, &state, true); err != nil {
+			resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to set protection for namespace %s, %v", name, err))
+		} else {
+			state.Protected = types.BoolValue(true)
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(namespaceIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -143,9 +238,71 @@ func (r *lakekeeperNamespaceResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	// TODO: implements
+	project_id := state.ProjectID.ValueString()
+	warehouse_name := state.WarehouseName.ValueString()
+
+	cat, err := r.client.CatalogV1(ctx, project_id, warehouse_name, r.catalogOptions...)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not create the Iceberg Catalog client", fmt.Sprintf("Unable to initialize the client, %v", err.Error()))
+		return
+	}
+
+	name := state.Name.ValueString()
+
+	_, err = cat.LoadNamespaceProperties(ctx, catalog.ToIdentifier(name))
+	if isNotFoundError(err) {
+		removeMissingResource(ctx, namespaceIdentity, req, resp)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("REST Catalog API error occurred", fmt.Sprintf("Unable to read namespace %s, %v", name, err.Error()))
+		return
+	}
+
+	// the IDs are null after an import
+	if err := r.resolveIDs(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read the ID of namespace %s, %v", name, err))
+		return
+	}
+
+	protection, _, err := r.client.WarehouseV1(project_id).GetNamespaceProtection(ctx, state.WarehouseID.ValueString(), state.NamespaceID.ValueString())
+	if isNotFoundError(err) {
+		// the namespace was recreated outside of Terraform with the same name
+		state.WarehouseID = types.StringNull()
+		state.NamespaceID = types.StringNull()
+		if err = r.resolveIDs(ctx, &state); err == nil {
+			protection, _, err = r.client.WarehouseV1(project_id).GetNamespaceProtection(ctx, state.WarehouseID.ValueString(), state.NamespaceID.ValueString())
+		}
+	}
+	switch {
+	case isUnsupportedEndpointError(err) && !state.Protected.ValueBool():
+		// the protection was never set, the server does not need to support it
+		tflog.Debug(ctx, "namespace protection is not supported by the server", map[string]any{
+			"id": state.ID.ValueString(),
+		})
+		state.Protected = types.BoolValue(false)
+	case err != nil:
+		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read protection of namespace %s, %v", name, capabilityError(ctx, r.client, capabilityNamespaceProtection, err)))
+		return
+	default:
+		state.Protected = types.BoolValue(protection.Protected)
+	}
+
+	state.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", project_id, warehouse_name, name))
+
+	// the attributes are not known by Lakekeeper, they are null after an import
+	if state.ForceDestroy.IsNull() {
+		state.ForceDestroy = types.BoolValue(false)
+	}
+	if state.RecursiveDelete.IsNull() {
+		state.RecursiveDelete = types.BoolValue(false)
+	}
+	if state.Purge.IsNull() {
+		state.Purge = types.BoolValue(false)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(namespaceIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Updates updates the resource in-place.
@@ -159,7 +316,25 @@ func (r *lakekeeperNamespaceResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	if !plan.Protected.Equal(state.Protected) {
+		name := state.Name.ValueString()
+		if err := r.resolveIDs(ctx, &state); err != nil {
+			resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read the ID of namespace %s, %v", name, err))
+			return
+		}
+		if err := r.setProtection(ctx, &state, plan.Protected.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to set protection for namespace %s, %v", name, err))
+			return
+		}
+		state.Protected = plan.Protected
+	}
+
+	state.ForceDestroy = plan.ForceDestroy
+	state.RecursiveDelete = plan.RecursiveDelete
+	state.Purge = plan.Purge
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(namespaceIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Deletes removes the resource.
@@ -176,15 +351,60 @@ func (r *lakekeeperNamespaceResource) Delete(ctx context.Context, req resource.D
 	project_id := state.ProjectID.ValueString()
 	warehouse_name := state.WarehouseName.ValueString()
 
-	cat, err := r.client.CatalogV1(ctx, project_id, warehouse_name)
+	cat, err := r.client.CatalogV1(ctx, project_id, warehouse_name, r.catalogOptions...)
 	if err != nil {
 		resp.Diagnostics.AddError("Could not create the Iceberg Catalog client", fmt.Sprintf("Unable to initialize the client, %v", err.Error()))
 		return
 	}
 
 	name := state.Name.ValueString()
+	namespace := catalog.ToIdentifier(name)
+
+	if state.Protected.ValueBool() && !state.ForceDestroy.ValueBool() {
+		resp.Diagnostics.AddError("Namespace is protected",
+			fmt.Sprintf("Namespace %s is protected from being dropped. Set `protected` to false before destroying the namespace, or set `force_destroy` to true.", name))
+		return
+	}
+
+	if state.ForceDestroy.ValueBool() || state.RecursiveDelete.ValueBool() {
+		// the IDs are null when the state was not refreshed since an upgrade of the provider
+		if err := r.resolveIDs(ctx, &state); isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		} else if err != nil {
+			resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read the ID of namespace %s, %v", name, err))
+			return
+		}
+
+		if _, err := api.DropNamespace(ctx, r.client, state.WarehouseID.ValueString(), namespace, &api.DropNamespaceOptions{
+			Force:     state.ForceDestroy.ValueBool(),
+			Recursive: true,
+			Purge:     state.Purge.ValueBool(),
+		}); err != nil && !isNotFoundError(err) {
+			resp.Diagnostics.AddError("REST Catalog API error occurred", fmt.Sprintf("Unable to delete namespace %s, %v", name, err.Error()))
+			return
+		}
+
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tables, views, namespaces, err := namespaceContent(ctx, cat, namespace)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("REST Catalog API error occurred", fmt.Sprintf("Unable to list the content of namespace %s, %v", name, err.Error()))
+		return
+	}
+	if tables+views+namespaces > 0 {
+		resp.Diagnostics.AddError("Namespace is not empty",
+			fmt.Sprintf("Namespace %s still has %d table(s), %d view(s) and %d namespace(s). Drop them before destroying the namespace, or set `recursive_delete` to true.", name, tables, views, namespaces))
+		return
+	}
 
-	if err := cat.DropNamespace(ctx, catalog.ToIdentifier(name)); err != nil {
+	if err := cat.DropNamespace(ctx, namespace); err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError("REST Catalog API error occurred", fmt.Sprintf("Unable to delete namespace %s, %v", name, err.Error()))
 		return
 	}
@@ -192,21 +412,129 @@ func (r *lakekeeperNamespaceResource) Delete(ctx context.Context, req resource.D
 	resp.State.RemoveResource(ctx)
 }
 
-// ImportState imports the resource into the Terraform state.
+// resolveIDs sets the IDs of the warehouse and of the namespace, required by the management
+// API, when they are not known yet.
+func (r *lakekeeperNamespaceResource) resolveIDs(ctx context.Context, state *lakekeeperNamespaceResourceModel) error {
+	if state.WarehouseID.IsNull() || state.WarehouseID.IsUnknown() {
+		warehouse, err := findWarehouseByName(ctx, r.client, state.ProjectID.ValueString(), state.WarehouseName.ValueString())
+		if err != nil {
+			return err
+		}
+		state.WarehouseID = types.StringValue(warehouse.ID)
+	}
+
+	if state.NamespaceID.IsNull() || state.NamespaceID.IsUnknown() {
+		namespaceID, err := api.GetNamespaceID(ctx, r.client, state.WarehouseID.ValueString(), catalog.ToIdentifier(state.Name.ValueString()))
+		if err != nil {
+			return err
+		}
+		state.NamespaceID = types.StringValue(namespaceID)
+	}
+
+	return nil
+}
+
+// setProtection protects the namespace from being dropped, or removes its protection.
+func (r *lakekeeperNamespaceResource) setProtection(ctx context.Context, state *lakekeeperNamespaceResourceModel, protected bool) error {
+	if _, _, err := r.client.WarehouseV1(state.ProjectID.ValueString()).SetNamespaceProtection(ctx, state.WarehouseID.ValueString(), state.NamespaceID.ValueString(), &managementv1.SetProtectionOptions{Protected: protected}); err != nil {
+		return capabilityError(ctx, r.client, capabilityNamespaceProtection, err)
+	}
+
+	return nil
+}
+
+// namespaceContent counts the tables, views and nested namespaces directly under a namespace.
+func namespaceContent(ctx context.Context, cat *rest.Catalog, namespace table.Identifier) (tables, views, namespaces int, err error) {
+	for _, err := range cat.ListTables(ctx, namespace) {
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		tables++
+	}
+
+	for _, err := range cat.ListViews(ctx, namespace) {
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		views++
+	}
+
+	children, err := cat.ListNamespaces(ctx, namespace)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return tables, views, len(children), nil
+}
+
+// IdentitySchema defines the identity of the resource, used by import blocks.
+func (r *lakekeeperNamespaceResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = namespaceIdentity.Schema()
+}
+
+// ImportState imports the resource into the Terraform state, either from its ID or from its identity.
 func (r *lakekeeperNamespaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Expected format: "project_id/warehouse_name/name"
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 3 {
-		resp.Diagnostics.AddError(
-			"Invalid import ID format",
-			"Expected format: project_id/warehouse_name/name",
-		)
+	namespaceIdentity.ImportState(ctx, req, resp)
+}
+
+// lakekeeperNamespaceListModel describes the config data model of the list resource.
+type lakekeeperNamespaceListModel struct {
+	ProjectID     types.String `tfsdk:"project_id"`
+	WarehouseName types.String `tfsdk:"warehouse_name"`
+}
+
+// ListResourceConfigSchema defines the config of the list resource, used by list blocks.
+func (r *lakekeeperNamespaceResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the namespaces of a warehouse, including the nested ones.",
+		Attributes: map[string]listschema.Attribute{
+			"project_id": listschema.StringAttribute{
+				MarkdownDescription: "The ID of the project.",
+				Required:            true,
+			},
+			"warehouse_name": listschema.StringAttribute{
+				MarkdownDescription: "The name of the warehouse.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+// List lists the namespaces of the warehouse, walking down the nested namespaces.
+func (r *lakekeeperNamespaceResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config lakekeeperNamespaceListModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
 		return
 	}
 
-	resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])
-	resp.State.SetAttribute(ctx, path.Root("warehouse_name"), parts[1])
-	resp.State.SetAttribute(ctx, path.Root("name"), parts[2])
+	projectID := config.ProjectID.ValueString()
+	warehouseName := config.WarehouseName.ValueString()
+
+	cat, err := r.client.CatalogV1(ctx, projectID, warehouseName, r.catalogOptions...)
+	if err != nil {
+		diags.AddError("Could not create the Iceberg Catalog client", fmt.Sprintf("Unable to initialize the client, %v", err.Error()))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var listed []listedResource
+	parents := []table.Identifier{nil}
+	for len(parents) > 0 {
+		children, err := cat.ListNamespaces(ctx, parents[0])
+		if err != nil {
+			diags.AddError("REST Catalog API error occurred", fmt.Sprintf("Unable to list namespaces of warehouse %s, %v", warehouseName, err.Error()))
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+		parents = append(parents[1:], children...)
+
+		for _, ns := range children {
+			name := strings.Join(ns, ".")
+			listed = append(listed, listedResource{ImportID: fmt.Sprintf("%s/%s/%s", projectID, warehouseName, name), DisplayName: name})
+		}
+	}
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	stream.Results = listResults(ctx, req, r, listed)
 }
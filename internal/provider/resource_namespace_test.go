@@ -6,15 +6,21 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/apache/iceberg-go"
 	"github.com/apache/iceberg-go/catalog"
 	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/testutil"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccLakekeeperNamespace_basic(t *testing.T) {
@@ -23,6 +29,7 @@ func TestAccLakekeeperNamespace_basic(t *testing.T) {
 
 	keyPrefix := fmt.Sprintf("key-prefix-%d", rand.Int())
 	warehouse := testutil.CreateWarehouse(t, project.ID, keyPrefix)
+	otherWarehouse := testutil.CreateWarehouse(t, project.ID, keyPrefix+"-other")
 
 	rName := acctest.RandString(8)
 
@@ -43,6 +50,8 @@ func TestAccLakekeeperNamespace_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("lakekeeper_namespace.this", "project_id", project.ID),
 					resource.TestCheckResourceAttr("lakekeeper_namespace.this", "warehouse_name", warehouse.Name),
 					resource.TestCheckResourceAttr("lakekeeper_namespace.this", "name", rName),
+					resource.TestCheckResourceAttr("lakekeeper_namespace.this", "warehouse_id", warehouse.ID),
+					resource.TestCheckResourceAttrSet("lakekeeper_namespace.this", "namespace_id"),
 				),
 			},
 			// Verify import
@@ -51,6 +60,183 @@ func TestAccLakekeeperNamespace_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// the namespace is recreated in another warehouse
+			{
+				Config: fmt.Sprintf(`
+				resource "lakekeeper_namespace" "this" {
+					project_id = "%s"
+					warehouse_name = "%s"
+					name = "%s"
+				}
+				`, project.ID, otherWarehouse.Name, rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("lakekeeper_namespace.this", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakekeeper_namespace.this", "warehouse_name", otherWarehouse.Name),
+					resource.TestCheckResourceAttr("lakekeeper_namespace.this", "warehouse_id", otherWarehouse.ID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLakekeeperNamespace_forceDestroy(t *testing.T) {
+
+	project := testutil.CreateProject(t)
+
+	keyPrefix := fmt.Sprintf("key-prefix-%d", rand.Int())
+	warehouse := testutil.CreateWarehouse(t, project.ID, keyPrefix)
+
+	rName := acctest.RandString(8)
+
+	config := func(forceDestroy bool) string {
+		return fmt.Sprintf(`
+		resource "lakekeeper_namespace" "this" {
+			project_id = "%s"
+			warehouse_name = "%s"
+			name = "%s"
+			force_destroy = %t
+		}
+		`, project.ID, warehouse.Name, rName, forceDestroy)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckLakekeeperNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakekeeper_namespace.this", "force_destroy", "false"),
+				),
+			},
+			// the child namespace is not dropped
+			{
+				PreConfig: func() {
+					cat, err := testutil.TestLakekeeperClient.CatalogV1(t.Context(), project.ID, warehouse.Name)
+					if err != nil {
+						t.Fatalf("could not create the Iceberg Catalog client: %v", err)
+					}
+					if err := cat.CreateNamespace(t.Context(), catalog.ToIdentifier(rName, "child"), nil); err != nil {
+						t.Fatalf("could not create child namespace: %v", err)
+					}
+				},
+				Config:      config(false),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Namespace is not empty"),
+			},
+			// the namespace is dropped along with its child namespace
+			{
+				Config: config(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakekeeper_namespace.this", "force_destroy", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLakekeeperNamespace_recursiveDelete(t *testing.T) {
+
+	project := testutil.CreateProject(t)
+
+	keyPrefix := fmt.Sprintf("key-prefix-%d", rand.Int())
+	warehouse := testutil.CreateWarehouse(t, project.ID, keyPrefix)
+
+	rName := acctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckLakekeeperNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "lakekeeper_namespace" "this" {
+					project_id = "%s"
+					warehouse_name = "%s"
+					name = "%s"
+					recursive_delete = true
+					purge = true
+				}
+				`, project.ID, warehouse.Name, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakekeeper_namespace.this", "recursive_delete", "true"),
+					resource.TestCheckResourceAttr("lakekeeper_namespace.this", "purge", "true"),
+					resource.TestCheckResourceAttr("lakekeeper_namespace.this", "force_destroy", "false"),
+					func(_ *terraform.State) error {
+						// the table and the child namespace are dropped with the namespace
+						cat, err := testutil.TestLakekeeperClient.CatalogV1(t.Context(), project.ID, warehouse.Name)
+						if err != nil {
+							return fmt.Errorf("could not create the Iceberg Catalog client: %w", err)
+						}
+						if err := cat.CreateNamespace(t.Context(), catalog.ToIdentifier(rName, "child"), nil); err != nil {
+							return fmt.Errorf("could not create child namespace: %w", err)
+						}
+						schema := iceberg.NewSchema(0, iceberg.NestedField{ID: 1, Name: "id", Type: iceberg.PrimitiveTypes.Int64, Required: true})
+						if _, err := cat.CreateTable(t.Context(), catalog.ToIdentifier(rName, "events"), schema); err != nil {
+							return fmt.Errorf("could not create table: %w", err)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccLakekeeperNamespace_protected(t *testing.T) {
+
+	project := testutil.CreateProject(t)
+
+	keyPrefix := fmt.Sprintf("key-prefix-%d", rand.Int())
+	warehouse := testutil.CreateWarehouse(t, project.ID, keyPrefix)
+
+	rName := acctest.RandString(8)
+
+	config := func(protected bool) string {
+		return fmt.Sprintf(`
+		resource "lakekeeper_namespace" "this" {
+			project_id = "%s"
+			warehouse_name = "%s"
+			name = "%s"
+			protected = %t
+		}
+		`, project.ID, warehouse.Name, rName, protected)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckLakekeeperNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakekeeper_namespace.this", "protected", "true"),
+					resource.TestCheckResourceAttr("lakekeeper_namespace.this", "warehouse_id", warehouse.ID),
+					resource.TestCheckResourceAttrSet("lakekeeper_namespace.this", "namespace_id"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "lakekeeper_namespace.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// the namespace cannot be dropped
+			{
+				Config:      config(true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Namespace is protected"),
+			},
+			{
+				Config: config(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakekeeper_namespace.this", "protected", "false"),
+				),
+			},
 		},
 	})
 }
@@ -84,3 +270,60 @@ func testAccCheckLakekeeperNamespaceDestroy(s *terraform.State) error {
 	}
 	return nil
 }
+
+func TestAccLakekeeperNamespace_list(t *testing.T) {
+
+	project := testutil.CreateProject(t)
+
+	keyPrefix := fmt.Sprintf("key-prefix-%d", rand.Int())
+	warehouse := testutil.CreateWarehouse(t, project.ID, keyPrefix)
+
+	rName := acctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckLakekeeperNamespaceDestroy,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "lakekeeper_namespace" "parent" {
+					project_id = "%s"
+					warehouse_name = "%s"
+					name = "%s"
+				}
+				resource "lakekeeper_namespace" "child" {
+					project_id = "%s"
+					warehouse_name = "%s"
+					name = "${lakekeeper_namespace.parent.name}.child"
+				}
+				`, project.ID, warehouse.Name, rName, project.ID, warehouse.Name),
+			},
+			// nested namespaces are listed too
+			{
+				Query: true,
+				Config: fmt.Sprintf(`
+				provider "lakekeeper" {}
+				list "lakekeeper_namespace" "all" {
+				  provider = lakekeeper
+
+				  config {
+				    project_id = "%s"
+				    warehouse_name = "%s"
+				  }
+				}
+				`, project.ID, warehouse.Name),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("lakekeeper_namespace.all", 2),
+					querycheck.ExpectIdentity("lakekeeper_namespace.all", map[string]knownvalue.Check{
+						"project_id":     knownvalue.StringExact(project.ID),
+						"warehouse_name": knownvalue.StringExact(warehouse.Name),
+						"name":           knownvalue.StringExact(rName + ".child"),
+					}),
+				},
+			},
+		},
+	})
+}
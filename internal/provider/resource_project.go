@@ -3,13 +3,18 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
 	managementv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1"
 	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -22,10 +27,18 @@ var (
 	_ resource.Resource                = &lakekeeperProjectResource{}
 	_ resource.ResourceWithConfigure   = &lakekeeperProjectResource{}
 	_ resource.ResourceWithImportState = &lakekeeperProjectResource{}
+	_ resource.ResourceWithIdentity    = &lakekeeperProjectResource{}
+	_ list.ListResourceWithConfigure   = &lakekeeperProjectResource{}
 )
 
+// projectIdentity is the identity of the resource, the import ID is `{{project_id}}`.
+var projectIdentity = resourceIdentity{
+	{Name: "project_id", StateAttribute: "id", Description: "The ID of the project."},
+}
+
 func init() {
 	registerResource(NewLakekeeperProjectResource)
+	registerListResource(NewLakekeeperProjectListResource)
 }
 
 // NewLakekeeperProjectResource is a helper function to simplify the provider implementation.
@@ -33,6 +46,11 @@ func NewLakekeeperProjectResource() resource.Resource {
 	return &lakekeeperProjectResource{}
 }
 
+// NewLakekeeperProjectListResource is a helper function to simplify the provider implementation.
+func NewLakekeeperProjectListResource() list.ListResource {
+	return &lakekeeperProjectResource{}
+}
+
 func (r *lakekeeperProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_project"
 }
@@ -44,8 +62,10 @@ type lakekeeperProjectResource struct {
 
 // lakekeeperProjectResourceModel describes the resource data model.
 type lakekeeperProjectResourceModel struct {
-	ID   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
+	ID                         types.String `tfsdk:"id"`
+	ProjectID                  types.String `tfsdk:"project_id"`
+	Name                       types.String `tfsdk:"name"`
+	PreventDestroyWhenNotEmpty types.Bool   `tfsdk:"prevent_destroy_when_not_empty"`
 }
 
 func (r *lakekeeperProjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -62,11 +82,29 @@ func (r *lakekeeperProjectResource) Schema(ctx context.Context, req resource.Sch
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project, requested at creation so that it is identical across environments. Generated by Lakekeeper when not set, which is recommended.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile("^[^/]+$"), "must not include a slash"),
+				},
+			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Name of the project.",
 				Required:            true,
 				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
 			},
+			"prevent_destroy_when_not_empty": schema.BoolAttribute{
+				MarkdownDescription: "Whether the destroy fails with the list of the warehouses of the project when it still has some, instead of calling the API. Default is `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -96,6 +134,7 @@ func (r *lakekeeperProjectResource) Create(ctx context.Context, req resource.Cre
 	name := state.Name.ValueString()
 
 	opts := managementv1.CreateProjectOptions{
+		ID:   state.ProjectID.ValueStringPointer(),
 		Name: name,
 	}
 
@@ -106,6 +145,7 @@ func (r *lakekeeperProjectResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	state.ID = types.StringValue(project.ID)
+	state.ProjectID = types.StringValue(project.ID)
 
 	// Log the creation of the resource
 	tflog.Debug(ctx, "created an application", map[string]any{
@@ -113,6 +153,7 @@ func (r *lakekeeperProjectResource) Create(ctx context.Context, req resource.Cre
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(projectIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -129,15 +170,26 @@ func (r *lakekeeperProjectResource) Read(ctx context.Context, req resource.ReadR
 	id := state.ID.ValueString()
 
 	project, _, err := r.client.ProjectV1().Get(ctx, id)
+	if isNotFoundError(err) {
+		removeMissingResource(ctx, projectIdentity, req, resp)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read project %s, %v", id, err.Error()))
 		return
 	}
 
 	state.ID = types.StringValue(project.ID)
+	state.ProjectID = types.StringValue(project.ID)
 	state.Name = types.StringValue(project.Name)
 
+	// the attribute is not known by Lakekeeper, it is null after an import
+	if state.PreventDestroyWhenNotEmpty.IsNull() {
+		state.PreventDestroyWhenNotEmpty = types.BoolValue(false)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(projectIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Updates updates the resource in-place.
@@ -171,7 +223,9 @@ func (r *lakekeeperProjectResource) Update(ctx context.Context, req resource.Upd
 
 	// Update the state with the new name
 	state.Name = plan.Name
+	state.PreventDestroyWhenNotEmpty = plan.PreventDestroyWhenNotEmpty
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(projectIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Deletes removes the resource.
@@ -187,8 +241,27 @@ func (r *lakekeeperProjectResource) Delete(ctx context.Context, req resource.Del
 
 	id := state.ID.ValueString()
 
+	if state.PreventDestroyWhenNotEmpty.ValueBool() {
+		warehouses, _, err := r.client.WarehouseV1(id).List(ctx, &managementv1.ListWarehouseOptions{
+			WarehouseStatus: []managementv1.WarehouseStatus{managementv1.WarehouseStatusActive, managementv1.WarehouseStatusInactive},
+		})
+		if err != nil && !isNotFoundError(err) {
+			resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to list the warehouses of project %s, %v", id, err))
+			return
+		}
+		if err == nil && len(warehouses.Warehouses) > 0 {
+			names := make([]string, len(warehouses.Warehouses))
+			for i, w := range warehouses.Warehouses {
+				names[i] = w.Name
+			}
+			resp.Diagnostics.AddError("Project is not empty",
+				fmt.Sprintf("Project %s still has %d warehouse(s): %s. Delete them before destroying the project, or set `prevent_destroy_when_not_empty` to false.", id, len(names), strings.Join(names, ", ")))
+			return
+		}
+	}
+
 	_, err := r.client.ProjectV1().Delete(ctx, id)
-	if err != nil {
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to delete project %s, %v", id, err))
 		return
 	}
@@ -196,7 +269,38 @@ func (r *lakekeeperProjectResource) Delete(ctx context.Context, req resource.Del
 	resp.State.RemoveResource(ctx)
 }
 
-// ImportState imports the resource into the Terraform state.
+// IdentitySchema defines the identity of the resource, used by import blocks.
+func (r *lakekeeperProjectResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = projectIdentity.Schema()
+}
+
+// ImportState imports the resource into the Terraform state, either from its ID or from its identity.
 func (r *lakekeeperProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	projectIdentity.ImportState(ctx, req, resp)
+}
+
+// ListResourceConfigSchema defines the config of the list resource, used by list blocks.
+func (r *lakekeeperProjectResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the projects visible to the provider.",
+	}
+}
+
+// List lists the projects visible to the provider.
+func (r *lakekeeperProjectResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+
+	projects, _, err := r.client.ProjectV1().List(ctx)
+	if err != nil {
+		diags.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to list projects, %v", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var listed []listedResource
+	for _, p := range projects.Projects {
+		listed = append(listed, listedResource{ImportID: p.ID, DisplayName: p.Name})
+	}
+
+	stream.Results = listResults(ctx, req, r, listed)
 }
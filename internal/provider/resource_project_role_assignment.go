@@ -4,14 +4,14 @@ import (
 	"context"
 	"fmt"
 	"regexp"
-	"strings"
 
 	permissionv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1/permission"
 	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -25,10 +25,19 @@ var (
 	_ resource.Resource                = &lakekeeperProjectRoleAssignmentResource{}
 	_ resource.ResourceWithConfigure   = &lakekeeperProjectRoleAssignmentResource{}
 	_ resource.ResourceWithImportState = &lakekeeperProjectRoleAssignmentResource{}
+	_ resource.ResourceWithIdentity    = &lakekeeperProjectRoleAssignmentResource{}
+	_ list.ListResourceWithConfigure   = &lakekeeperProjectRoleAssignmentResource{}
 )
 
+// projectRoleAssignmentIdentity is the identity of the resource, the import ID is `{{project_id}}/{{role_id}}`.
+var projectRoleAssignmentIdentity = resourceIdentity{
+	{Name: "project_id", Description: "The ID of the project."},
+	{Name: "role_id", Description: "The ID of the assigned role."},
+}
+
 func init() {
 	registerResource(NewLakekeeperProjectRoleAssignment)
+	registerListResource(NewLakekeeperProjectRoleAssignmentListResource)
 }
 
 // NewLakekeeperProjectAssignment is a helper function to simplify the provider implementation.
@@ -36,6 +45,11 @@ func NewLakekeeperProjectRoleAssignment() resource.Resource {
 	return &lakekeeperProjectRoleAssignmentResource{}
 }
 
+// NewLakekeeperProjectRoleAssignmentListResource is a helper function to simplify the provider implementation.
+func NewLakekeeperProjectRoleAssignmentListResource() list.ListResource {
+	return &lakekeeperProjectRoleAssignmentResource{}
+}
+
 func (r *lakekeeperProjectRoleAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_project_role_assignment"
 }
@@ -167,6 +181,7 @@ func (r *lakekeeperProjectRoleAssignmentResource) Create(ctx context.Context, re
 	state.Assignments = plan.Assignments
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(projectRoleAssignmentIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -180,9 +195,17 @@ func (r *lakekeeperProjectRoleAssignmentResource) Read(ctx context.Context, req
 		return
 	}
 
-	projectID, roleID := splitInternalID(state.ID)
+	projectID, roleID, diags := splitInternalID(state.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	assignments, _, err := r.client.PermissionV1().ProjectPermission().GetAssignments(ctx, projectID, nil)
+	if isNotFoundError(err) {
+		removeMissingResource(ctx, projectRoleAssignmentIdentity, req, resp)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read project assignments, %s", err.Error()))
 		return
@@ -207,6 +230,7 @@ func (r *lakekeeperProjectRoleAssignmentResource) Read(ctx context.Context, req
 
 	state.Assignments = newAssignments
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(projectRoleAssignmentIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Updates updates the resource in-place.
@@ -269,6 +293,7 @@ func (r *lakekeeperProjectRoleAssignmentResource) Update(ctx context.Context, re
 	state.Assignments = plan.Assignments
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(projectRoleAssignmentIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Deletes removes the resource.
@@ -302,27 +327,64 @@ func (r *lakekeeperProjectRoleAssignmentResource) Delete(ctx context.Context, re
 
 	if _, err := r.client.PermissionV1().ProjectPermission().Update(ctx, state.ProjectID.ValueString(), &permissionv1.UpdateProjectPermissionsOptions{
 		Deletes: deletes,
-	}); err != nil {
+	}); err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to delete project assignment, %v", err.Error()))
+		return
 	}
 
 	resp.State.RemoveResource(ctx)
 }
 
-// ImportState imports the resource into the Terraform state.
+// IdentitySchema defines the identity of the resource, used by import blocks.
+func (r *lakekeeperProjectRoleAssignmentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = projectRoleAssignmentIdentity.Schema()
+}
+
+// ImportState imports the resource into the Terraform state, either from its ID or from its identity.
 func (r *lakekeeperProjectRoleAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Expected format: "project_id/role_id"
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid import ID format",
-			"Expected format: project_id/role_id",
-		)
+	projectRoleAssignmentIdentity.ImportState(ctx, req, resp)
+}
+
+// lakekeeperProjectRoleAssignmentListModel describes the config data model of the list resource.
+type lakekeeperProjectRoleAssignmentListModel struct {
+	ProjectID types.String `tfsdk:"project_id"`
+}
+
+// ListResourceConfigSchema defines the config of the list resource, used by list blocks.
+func (r *lakekeeperProjectRoleAssignmentResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the roles having assignments on a project.",
+		Attributes: map[string]listschema.Attribute{
+			"project_id": listschema.StringAttribute{
+				MarkdownDescription: "The ID of the project.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+// List lists the roles having assignments on a project.
+func (r *lakekeeperProjectRoleAssignmentResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config lakekeeperProjectRoleAssignmentListModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	projectID := config.ProjectID.ValueString()
+
+	assignments, _, err := r.client.PermissionV1().ProjectPermission().GetAssignments(ctx, projectID, nil)
+	if err != nil {
+		diags.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read project assignments, %s", err.Error()))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
 		return
 	}
 
-	resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])
-	resp.State.SetAttribute(ctx, path.Root("role_id"), parts[1])
+	var listed []listedResource
+	for _, id := range assignees(assignments.Assignments, permissionv1.RoleType) {
+		listed = append(listed, listedResource{ImportID: projectID + "/" + id, DisplayName: id})
+	}
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	stream.Results = listResults(ctx, req, r, listed)
 }
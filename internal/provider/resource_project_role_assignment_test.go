@@ -13,7 +13,10 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccLakekeeperProjectRoleAssignment_basic(t *testing.T) {
@@ -137,7 +140,10 @@ func testAccCheckLakekeeperProjectRoleAssignmentDestroy(s *terraform.State) erro
 			continue
 		}
 
-		projectID, roleID := splitInternalID(types.StringValue(rs.Primary.ID))
+		projectID, roleID, diags := splitInternalID(types.StringValue(rs.Primary.ID))
+		if diags.HasError() {
+			return fmt.Errorf("invalid resource ID %s", rs.Primary.ID)
+		}
 
 		assignments, _, err := testutil.TestLakekeeperClient.PermissionV1().ProjectPermission().GetAssignments(context.Background(), projectID, nil)
 		if err != nil {
@@ -153,3 +159,49 @@ func testAccCheckLakekeeperProjectRoleAssignmentDestroy(s *terraform.State) erro
 
 	return nil
 }
+
+func TestAccLakekeeperProjectRoleAssignment_list(t *testing.T) {
+
+	project := testutil.CreateProject(t)
+	role := testutil.CreateRole(t, project.ID)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckLakekeeperProjectRoleAssignmentDestroy,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "lakekeeper_project_role_assignment" "test" {
+						project_id = "%s"
+						role_id = "%s"
+						assignments = ["data_admin", "describe"]
+					}
+				`, project.ID, role.ID),
+			},
+			// the role is listed once, whatever its number of assignments
+			{
+				Query: true,
+				Config: fmt.Sprintf(`
+				provider "lakekeeper" {}
+				list "lakekeeper_project_role_assignment" "all" {
+				  provider = lakekeeper
+
+				  config {
+				    project_id = "%s"
+				  }
+				}
+				`, project.ID),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("lakekeeper_project_role_assignment.all", 1),
+					querycheck.ExpectIdentity("lakekeeper_project_role_assignment.all", map[string]knownvalue.Check{
+						"project_id": knownvalue.StringExact(project.ID),
+						"role_id":    knownvalue.StringExact(role.ID),
+					}),
+				},
+			},
+		},
+	})
+}
@@ -5,9 +5,15 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
+	managementv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1"
+	"github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1/storage/credential"
+	"github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1/storage/profile"
+	"github.com/baptistegh/go-lakekeeper/pkg/core"
 	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/testutil"
+	"github.com/google/uuid"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -76,6 +82,122 @@ func TestAccLakekeeperProject_rename(t *testing.T) {
 	})
 }
 
+func TestAccLakekeeperProject_disappears(t *testing.T) {
+	rName := acctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckLakekeeperProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "lakekeeper_project" "foo" {
+				  name = "%s"
+				}
+				`, rName),
+				// the project is deleted outside of Terraform, it must be planned for creation
+				Check: func(s *terraform.State) error {
+					rs := s.RootModule().Resources["lakekeeper_project.foo"]
+					_, err := testutil.TestLakekeeperClient.ProjectV1().Delete(context.Background(), rs.Primary.ID)
+					return err
+				},
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLakekeeperProject_projectID(t *testing.T) {
+	rName := acctest.RandString(8)
+	projectID := uuid.NewString()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckLakekeeperProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "lakekeeper_project" "foo" {
+				  project_id = "%s"
+				  name       = "%s"
+				}
+				`, projectID, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakekeeper_project.foo", "id", projectID),
+					resource.TestCheckResourceAttr("lakekeeper_project.foo", "project_id", projectID),
+					resource.TestCheckResourceAttr("lakekeeper_project.foo", "prevent_destroy_when_not_empty", "false"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "lakekeeper_project.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLakekeeperProject_preventDestroyWhenNotEmpty(t *testing.T) {
+	rName := acctest.RandString(8)
+
+	var projectID, warehouseID string
+
+	config := fmt.Sprintf(`
+	resource "lakekeeper_project" "foo" {
+	  name                           = "%s"
+	  prevent_destroy_when_not_empty = true
+	}
+	`, rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckLakekeeperProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakekeeper_project.foo", "prevent_destroy_when_not_empty", "true"),
+					func(s *terraform.State) error {
+						projectID = s.RootModule().Resources["lakekeeper_project.foo"].Primary.ID
+						return nil
+					},
+				),
+			},
+			// the destroy is refused while the project has a warehouse
+			{
+				PreConfig: func() {
+					storage := profile.NewS3StorageSettings("testacc", "local-01",
+						profile.WithEndpoint("http://minio:9000/"),
+						profile.WithPathStyleAccess(),
+						profile.WithS3KeyPrefix(acctest.RandString(8)),
+					)
+					w, _, err := testutil.TestLakekeeperClient.WarehouseV1(projectID).Create(t.Context(), &managementv1.CreateWarehouseOptions{
+						Name:              acctest.RandString(8),
+						StorageProfile:    storage.AsProfile(),
+						StorageCredential: credential.NewS3CredentialAccessKey("minio-root-user", "minio-root-password").AsCredential(),
+					})
+					if err != nil {
+						t.Fatalf("could not create test warehouse: %v", err)
+					}
+					warehouseID = w.ID
+				},
+				Config:      config,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Project is not empty"),
+			},
+			{
+				PreConfig: func() {
+					if _, err := testutil.TestLakekeeperClient.WarehouseV1(projectID).Delete(t.Context(), warehouseID, &managementv1.DeleteWarehouseOptions{Force: core.Ptr(true)}); err != nil {
+						t.Fatalf("could not delete test warehouse: %v", err)
+					}
+				},
+				Config: config,
+			},
+		},
+	})
+}
+
 func testAccCheckLakekeeperProjectDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "lakekeeper_project" {
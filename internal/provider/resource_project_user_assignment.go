@@ -3,14 +3,14 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	permissionv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1/permission"
 	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -24,10 +24,19 @@ var (
 	_ resource.Resource                = &lakekeeperProjectUserAssignmentResource{}
 	_ resource.ResourceWithConfigure   = &lakekeeperProjectUserAssignmentResource{}
 	_ resource.ResourceWithImportState = &lakekeeperProjectUserAssignmentResource{}
+	_ resource.ResourceWithIdentity    = &lakekeeperProjectUserAssignmentResource{}
+	_ list.ListResourceWithConfigure   = &lakekeeperProjectUserAssignmentResource{}
 )
 
+// projectUserAssignmentIdentity is the identity of the resource, the import ID is `{{project_id}}/{{user_id}}`.
+var projectUserAssignmentIdentity = resourceIdentity{
+	{Name: "project_id", Description: "The ID of the project."},
+	{Name: "user_id", Description: "The ID of the assigned user."},
+}
+
 func init() {
 	registerResource(NewLakekeeperProjectUserAssignment)
+	registerListResource(NewLakekeeperProjectUserAssignmentListResource)
 }
 
 // NewLakekeeperProjectAssignment is a helper function to simplify the provider implementation.
@@ -35,6 +44,11 @@ func NewLakekeeperProjectUserAssignment() resource.Resource {
 	return &lakekeeperProjectUserAssignmentResource{}
 }
 
+// NewLakekeeperProjectUserAssignmentListResource is a helper function to simplify the provider implementation.
+func NewLakekeeperProjectUserAssignmentListResource() list.ListResource {
+	return &lakekeeperProjectUserAssignmentResource{}
+}
+
 func (r *lakekeeperProjectUserAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_project_user_assignment"
 }
@@ -164,6 +178,7 @@ func (r *lakekeeperProjectUserAssignmentResource) Create(ctx context.Context, re
 	state.Assignments = plan.Assignments
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(projectUserAssignmentIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -177,9 +192,17 @@ func (r *lakekeeperProjectUserAssignmentResource) Read(ctx context.Context, req
 		return
 	}
 
-	projectID, userID := splitInternalID(state.ID)
+	projectID, userID, diags := splitInternalID(state.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	assignments, _, err := r.client.PermissionV1().ProjectPermission().GetAssignments(ctx, projectID, nil)
+	if isNotFoundError(err) {
+		removeMissingResource(ctx, projectUserAssignmentIdentity, req, resp)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read project assignments, %s", err.Error()))
 		return
@@ -203,6 +226,7 @@ func (r *lakekeeperProjectUserAssignmentResource) Read(ctx context.Context, req
 	state.Assignments = newAssignments
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(projectUserAssignmentIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Updates updates the resource in-place.
@@ -265,6 +289,7 @@ func (r *lakekeeperProjectUserAssignmentResource) Update(ctx context.Context, re
 	state.Assignments = plan.Assignments
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(projectUserAssignmentIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Deletes removes the resource.
@@ -298,27 +323,64 @@ func (r *lakekeeperProjectUserAssignmentResource) Delete(ctx context.Context, re
 
 	if _, err := r.client.PermissionV1().ProjectPermission().Update(ctx, state.ProjectID.ValueString(), &permissionv1.UpdateProjectPermissionsOptions{
 		Deletes: deletes,
-	}); err != nil {
+	}); err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to delete project assignment, %v", err.Error()))
+		return
 	}
 
 	resp.State.RemoveResource(ctx)
 }
 
-// ImportState imports the resource into the Terraform state.
+// IdentitySchema defines the identity of the resource, used by import blocks.
+func (r *lakekeeperProjectUserAssignmentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = projectUserAssignmentIdentity.Schema()
+}
+
+// ImportState imports the resource into the Terraform state, either from its ID or from its identity.
 func (r *lakekeeperProjectUserAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Expected format: "project_id/user_id"
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid import ID format",
-			"Expected format: project_id/user_id",
-		)
+	projectUserAssignmentIdentity.ImportState(ctx, req, resp)
+}
+
+// lakekeeperProjectUserAssignmentListModel describes the config data model of the list resource.
+type lakekeeperProjectUserAssignmentListModel struct {
+	ProjectID types.String `tfsdk:"project_id"`
+}
+
+// ListResourceConfigSchema defines the config of the list resource, used by list blocks.
+func (r *lakekeeperProjectUserAssignmentResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the users having assignments on a project.",
+		Attributes: map[string]listschema.Attribute{
+			"project_id": listschema.StringAttribute{
+				MarkdownDescription: "The ID of the project.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+// List lists the users having assignments on a project.
+func (r *lakekeeperProjectUserAssignmentResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config lakekeeperProjectUserAssignmentListModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	projectID := config.ProjectID.ValueString()
+
+	assignments, _, err := r.client.PermissionV1().ProjectPermission().GetAssignments(ctx, projectID, nil)
+	if err != nil {
+		diags.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read project assignments, %s", err.Error()))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
 		return
 	}
 
-	resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])
-	resp.State.SetAttribute(ctx, path.Root("user_id"), parts[1])
+	var listed []listedResource
+	for _, id := range assignees(assignments.Assignments, permissionv1.UserType) {
+		listed = append(listed, listedResource{ImportID: projectID + "/" + id, DisplayName: id})
+	}
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	stream.Results = listResults(ctx, req, r, listed)
 }
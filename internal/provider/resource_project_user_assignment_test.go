@@ -128,7 +128,10 @@ func testAccCheckLakekeeperProjectUserAssignmentDestroy(s *terraform.State) erro
 			continue
 		}
 
-		projectID, userID := splitInternalID(types.StringValue(rs.Primary.ID))
+		projectID, userID, diags := splitInternalID(types.StringValue(rs.Primary.ID))
+		if diags.HasError() {
+			return fmt.Errorf("invalid resource ID %s", rs.Primary.ID)
+		}
 
 		assignments, _, err := testutil.TestLakekeeperClient.PermissionV1().ProjectPermission().GetAssignments(context.Background(), projectID, nil)
 		if err != nil {
@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ resource.Resource                   = &lakekeeperRBACApplyResource{}
+	_ resource.ResourceWithConfigure      = &lakekeeperRBACApplyResource{}
+	_ resource.ResourceWithValidateConfig = &lakekeeperRBACApplyResource{}
+)
+
+func init() {
+	registerResource(NewLakekeeperRBACApplyResource)
+}
+
+// NewLakekeeperRBACApplyResource is a helper function to simplify the provider implementation.
+func NewLakekeeperRBACApplyResource() resource.Resource {
+	return &lakekeeperRBACApplyResource{}
+}
+
+func (r *lakekeeperRBACApplyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rbac_apply"
+}
+
+// lakekeeperRBACApplyResource defines the resource implementation.
+type lakekeeperRBACApplyResource struct {
+	client *lakekeeper.Client
+}
+
+// lakekeeperRBACApplyResourceModel describes the resource data model.
+type lakekeeperRBACApplyResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	Snapshot  types.String `tfsdk:"snapshot"`
+	Prune     types.Bool   `tfsdk:"prune"`
+}
+
+func (r *lakekeeperRBACApplyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`lakekeeper_rbac_apply`" + ` resource reconciles the roles, the role memberships and the project and warehouse assignments of a project with an RBAC snapshot, usually read from another Lakekeeper with the ` + "`lakekeeper_rbac_snapshot`" + ` data source.
+
+The roles and the warehouses of the snapshot are matched by name in the target project. The missing users and roles are created, the warehouses must already exist. When the target drifts from the snapshot, the next plan shows an update of ` + "`snapshot`" + `.
+
+Destroying this resource leaves the roles and the assignments of the project unchanged.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource, the project ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the target project.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"snapshot": schema.StringAttribute{
+				MarkdownDescription: "The RBAC snapshot to apply, as returned by the `json` attribute of the `lakekeeper_rbac_snapshot` data source.",
+				Required:            true,
+			},
+			"prune": schema.BoolAttribute{
+				MarkdownDescription: "Whether the roles and the assignments of the target project which are not part of the snapshot are deleted, making the project identical to the snapshot. Otherwise they are left untouched. Default is `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *lakekeeperRBACApplyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceData := req.ProviderData.(*LakekeeperResourceData)
+	r.client = resourceData.Client
+}
+
+// ValidateConfig checks that the snapshot is a valid RBAC snapshot document.
+func (r *lakekeeperRBACApplyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config lakekeeperRBACApplyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Snapshot.IsUnknown() || config.Snapshot.IsNull() {
+		return
+	}
+
+	if _, err := parseRBACSnapshot(config.Snapshot.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("snapshot"), "Invalid RBAC snapshot", err.Error())
+	}
+}
+
+// Create applies the snapshot and adds the resource into the Terraform state.
+func (r *lakekeeperRBACApplyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan lakekeeperRBACApplyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.ProjectID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read compares the target project with the snapshot, the snapshot is replaced by the
+// one of the project when they differ, so that the drift is planned for an update.
+func (r *lakekeeperRBACApplyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state lakekeeperRBACApplyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := state.ProjectID.ValueString()
+
+	if _, _, err := r.client.ProjectV1().Get(ctx, projectID); isNotFoundError(err) {
+		tflog.Warn(ctx, "project not found, removing the RBAC apply from the state", map[string]any{
+			"project_id": projectID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	current, err := readRBACSnapshot(ctx, r.client, projectID)
+	if err != nil {
+		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read the RBAC snapshot of project %s, %v", projectID, err))
+		return
+	}
+
+	desired, err := parseRBACSnapshot(state.Snapshot.ValueString())
+	if err == nil && current.includes(desired) && (!state.Prune.ValueBool() || desired.includes(current)) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	document, err := current.JSON()
+	if err != nil {
+		resp.Diagnostics.AddError("Error encoding the RBAC snapshot", err.Error())
+		return
+	}
+	state.Snapshot = types.StringValue(document)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update applies the new snapshot.
+func (r *lakekeeperRBACApplyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan lakekeeperRBACApplyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from the state, the RBAC of the project is left as is.
+func (r *lakekeeperRBACApplyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state lakekeeperRBACApplyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Warn(ctx, "the roles and the assignments are left in place, only removing the RBAC apply from state", map[string]any{
+		"project_id": state.ProjectID.ValueString(),
+	})
+
+	resp.State.RemoveResource(ctx)
+}
+
+// apply reconciles the project of the model with its snapshot.
+func (r *lakekeeperRBACApplyResource) apply(ctx context.Context, model *lakekeeperRBACApplyResourceModel, diags *diag.Diagnostics) {
+	snapshot, err := parseRBACSnapshot(model.Snapshot.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("snapshot"), "Invalid RBAC snapshot", err.Error())
+		return
+	}
+
+	if err := applyRBACSnapshot(ctx, r.client, model.ProjectID.ValueString(), snapshot, model.Prune.ValueBool()); err != nil {
+		diags.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to apply the RBAC snapshot to project %s, %v", model.ProjectID.ValueString(), err))
+	}
+}
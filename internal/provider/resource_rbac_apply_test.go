@@ -0,0 +1,107 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	managementv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1"
+	permissionv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1/permission"
+	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/testutil"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLakekeeperRBACApply_basic(t *testing.T) {
+
+	source := testutil.CreateProject(t)
+	target := testutil.CreateProject(t)
+
+	role := testutil.CreateRole(t, source.ID)
+	user := testutil.CreateUser(t, fmt.Sprintf("oidc~%s", acctest.RandString(8)))
+
+	if _, err := testutil.TestLakekeeperClient.PermissionV1().RolePermission().Update(t.Context(), role.ID, &permissionv1.UpdateRolePermissionsOptions{
+		Writes: []*permissionv1.RoleAssignment{
+			{Assignee: permissionv1.UserOrRole{Type: permissionv1.UserType, Value: user.ID}, Assignment: permissionv1.AssigneeRoleAssignment},
+		},
+	}); err != nil {
+		t.Fatalf("could not assign the role: %v", err)
+	}
+
+	if _, err := testutil.TestLakekeeperClient.PermissionV1().ProjectPermission().Update(t.Context(), source.ID, &permissionv1.UpdateProjectPermissionsOptions{
+		Writes: []*permissionv1.ProjectAssignment{
+			{Assignee: permissionv1.UserOrRole{Type: permissionv1.RoleType, Value: role.ID}, Assignment: permissionv1.DataAdminProjectAssignment},
+		},
+	}); err != nil {
+		t.Fatalf("could not assign the project: %v", err)
+	}
+
+	// an extra role of the target, deleted by the prune
+	if _, _, err := testutil.TestLakekeeperClient.RoleV1(target.ID).Create(t.Context(), &managementv1.CreateRoleOptions{Name: acctest.RandString(8)}); err != nil {
+		t.Fatalf("could not create the extra role: %v", err)
+	}
+
+	config := fmt.Sprintf(`
+	data "lakekeeper_rbac_snapshot" "source" {
+		project_id = "%s"
+	}
+
+	resource "lakekeeper_rbac_apply" "target" {
+		project_id = "%s"
+		snapshot   = data.lakekeeper_rbac_snapshot.source.json
+		prune      = true
+	}`, source.ID, target.ID)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(`resource "lakekeeper_rbac_apply" "target" { project_id = "%s"  snapshot = "{}" }`, target.ID),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("unsupported RBAC snapshot version 0"),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakekeeper_rbac_apply.target", "id", target.ID),
+					resource.TestCheckResourceAttr("lakekeeper_rbac_apply.target", "prune", "true"),
+				),
+			},
+			// the RBAC of the target is now identical to the source
+			{
+				Config: config + fmt.Sprintf(`
+				data "lakekeeper_rbac_snapshot" "target" {
+					project_id = "%s"
+					depends_on = [lakekeeper_rbac_apply.target]
+				}`, target.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.lakekeeper_rbac_snapshot.source", "json", "data.lakekeeper_rbac_snapshot.target", "json"),
+				),
+			},
+			// a drift of the target is planned for an update
+			{
+				PreConfig: func() {
+					role, err := findRoleByName(t.Context(), testutil.TestLakekeeperClient, target.ID, role.Name)
+					if err != nil {
+						t.Fatalf("could not find the applied role: %v", err)
+					}
+					if _, err := testutil.TestLakekeeperClient.PermissionV1().ProjectPermission().Update(t.Context(), target.ID, &permissionv1.UpdateProjectPermissionsOptions{
+						Deletes: []*permissionv1.ProjectAssignment{
+							{Assignee: permissionv1.UserOrRole{Type: permissionv1.RoleType, Value: role.ID}, Assignment: permissionv1.DataAdminProjectAssignment},
+						},
+					}); err != nil {
+						t.Fatalf("could not remove the project assignment: %v", err)
+					}
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+			},
+		},
+	})
+}
@@ -3,11 +3,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	managementv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1"
 	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -21,8 +21,16 @@ var (
 	_ resource.Resource                = &lakekeeperRoleResource{}
 	_ resource.ResourceWithConfigure   = &lakekeeperRoleResource{}
 	_ resource.ResourceWithImportState = &lakekeeperRoleResource{}
+	_ resource.ResourceWithIdentity    = &lakekeeperRoleResource{}
+	_ list.ListResourceWithConfigure   = &lakekeeperRoleResource{}
 )
 
+// roleIdentity is the identity of the resource, the import ID is `{{project_id}}/{{role_id}}`.
+var roleIdentity = resourceIdentity{
+	{Name: "project_id", Description: "The ID of the project the role belongs to."},
+	{Name: "role_id", Description: "The ID of the role."},
+}
+
 type LakekeeperRoleResourceModel struct {
 	ID          types.String `tfsdk:"id"`
 	RoleID      types.String `tfsdk:"role_id"`
@@ -36,6 +44,7 @@ type LakekeeperRoleResourceModel struct {
 
 func init() {
 	registerResource(NewLakekeeperRoleResource)
+	registerListResource(NewLakekeeperRoleListResource)
 }
 
 // NewLakekeeperRoleResource is a helper function to simplify the provider implementation.
@@ -43,6 +52,11 @@ func NewLakekeeperRoleResource() resource.Resource {
 	return &lakekeeperRoleResource{}
 }
 
+// NewLakekeeperRoleListResource is a helper function to simplify the provider implementation.
+func NewLakekeeperRoleListResource() list.ListResource {
+	return &lakekeeperRoleResource{}
+}
+
 func (r *lakekeeperRoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_role"
 }
@@ -151,6 +165,7 @@ func (r *lakekeeperRoleResource) Create(ctx context.Context, req resource.Create
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(roleIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -164,9 +179,17 @@ func (r *lakekeeperRoleResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	projectID, roleID := splitInternalID(state.ID)
+	projectID, roleID, diags := splitInternalID(state.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	role, _, err := r.client.RoleV1(projectID).Get(ctx, roleID)
+	if isNotFoundError(err) {
+		removeMissingResource(ctx, roleIdentity, req, resp)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read role %s in project %s, %v", roleID, projectID, err))
 		return
@@ -184,6 +207,7 @@ func (r *lakekeeperRoleResource) Read(ctx context.Context, req resource.ReadRequ
 	state.UpdatedAt = types.StringPointerValue(role.UpdatedAt)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(roleIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Updates updates the resource in-place.
@@ -206,7 +230,11 @@ func (r *lakekeeperRoleResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	projectID, roleID := splitInternalID(state.ID)
+	projectID, roleID, diags := splitInternalID(state.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	opts := managementv1.UpdateRoleOptions{
 		Name:        plan.Name.ValueString(),
@@ -234,6 +262,7 @@ func (r *lakekeeperRoleResource) Update(ctx context.Context, req resource.Update
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(roleIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Deletes removes the resource.
@@ -247,10 +276,14 @@ func (r *lakekeeperRoleResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	projectID, roleID := splitInternalID(state.ID)
+	projectID, roleID, diags := splitInternalID(state.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	_, err := r.client.RoleV1(projectID).Delete(ctx, roleID)
-	if err != nil {
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to delete role %s in project %s, %v", roleID, projectID, err))
 		return
 	}
@@ -258,20 +291,63 @@ func (r *lakekeeperRoleResource) Delete(ctx context.Context, req resource.Delete
 	resp.State.RemoveResource(ctx)
 }
 
-// ImportState imports the resource into the Terraform state.
+// IdentitySchema defines the identity of the resource, used by import blocks.
+func (r *lakekeeperRoleResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = roleIdentity.Schema()
+}
+
+// ImportState imports the resource into the Terraform state, either from its ID or from its identity.
 func (r *lakekeeperRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Expected format: "project_id/role_id"
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid import ID format",
-			"Expected format: project_id/role_id",
-		)
+	roleIdentity.ImportState(ctx, req, resp)
+}
+
+// lakekeeperRoleListModel describes the config data model of the list resource.
+type lakekeeperRoleListModel struct {
+	ProjectID types.String `tfsdk:"project_id"`
+	Name      types.String `tfsdk:"name"`
+}
+
+// ListResourceConfigSchema defines the config of the list resource, used by list blocks.
+func (r *lakekeeperRoleResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the roles of a project.",
+		Attributes: map[string]listschema.Attribute{
+			"project_id": listschema.StringAttribute{
+				MarkdownDescription: "The ID of the project.",
+				Required:            true,
+			},
+			"name": listschema.StringAttribute{
+				MarkdownDescription: "Only list the roles whose name contains this string.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// List lists the roles of the project.
+func (r *lakekeeperRoleResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config lakekeeperRoleListModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
 		return
 	}
 
-	resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])
-	resp.State.SetAttribute(ctx, path.Root("role_id"), parts[1])
+	projectID := config.ProjectID.ValueString()
+
+	roles, err := listRoles(ctx, r.client, projectID, &managementv1.ListRolesOptions{
+		Name: config.Name.ValueStringPointer(),
+	})
+	if err != nil {
+		diags.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to list roles in project %s, %v", projectID, err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var listed []listedResource
+	for _, role := range roles {
+		listed = append(listed, listedResource{ImportID: projectID + "/" + role.ID, DisplayName: role.Name})
+	}
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	stream.Results = listResults(ctx, req, r, listed)
 }
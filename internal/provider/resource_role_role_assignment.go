@@ -4,14 +4,14 @@ import (
 	"context"
 	"fmt"
 	"regexp"
-	"strings"
 
 	permissionv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1/permission"
 	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -25,10 +25,19 @@ var (
 	_ resource.Resource                = &lakekeeperRoleRoleAssignmentResource{}
 	_ resource.ResourceWithConfigure   = &lakekeeperRoleRoleAssignmentResource{}
 	_ resource.ResourceWithImportState = &lakekeeperRoleRoleAssignmentResource{}
+	_ resource.ResourceWithIdentity    = &lakekeeperRoleRoleAssignmentResource{}
+	_ list.ListResourceWithConfigure   = &lakekeeperRoleRoleAssignmentResource{}
 )
 
+// roleRoleAssignmentIdentity is the identity of the resource, the import ID is `{{role_id}}/{{assignee_id}}`.
+var roleRoleAssignmentIdentity = resourceIdentity{
+	{Name: "role_id", Description: "The ID of the role."},
+	{Name: "assignee_id", Description: "The ID of the assigned role."},
+}
+
 func init() {
 	registerResource(NewLakekeeperRoleRoleAssignment)
+	registerListResource(NewLakekeeperRoleRoleAssignmentListResource)
 }
 
 // NewLakekeeperRoleAssignment is a helper function to simplify the provider implementation.
@@ -36,6 +45,11 @@ func NewLakekeeperRoleRoleAssignment() resource.Resource {
 	return &lakekeeperRoleRoleAssignmentResource{}
 }
 
+// NewLakekeeperRoleRoleAssignmentListResource is a helper function to simplify the provider implementation.
+func NewLakekeeperRoleRoleAssignmentListResource() list.ListResource {
+	return &lakekeeperRoleRoleAssignmentResource{}
+}
+
 func (r *lakekeeperRoleRoleAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_role_role_assignment"
 }
@@ -148,6 +162,7 @@ func (r *lakekeeperRoleRoleAssignmentResource) Create(ctx context.Context, req r
 	state.Assignments = plan.Assignments
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(roleRoleAssignmentIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -161,9 +176,17 @@ func (r *lakekeeperRoleRoleAssignmentResource) Read(ctx context.Context, req res
 		return
 	}
 
-	roleID, assigneeID := splitInternalID(state.ID)
+	roleID, assigneeID, diags := splitInternalID(state.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	assignments, _, err := r.client.PermissionV1().RolePermission().GetAssignments(ctx, roleID, nil)
+	if isNotFoundError(err) {
+		removeMissingResource(ctx, roleRoleAssignmentIdentity, req, resp)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read role assignments, %s", err.Error()))
 		return
@@ -187,6 +210,7 @@ func (r *lakekeeperRoleRoleAssignmentResource) Read(ctx context.Context, req res
 
 	state.Assignments = newAssignments
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(roleRoleAssignmentIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Updates updates the resource in-place.
@@ -238,7 +262,11 @@ func (r *lakekeeperRoleRoleAssignmentResource) Update(ctx context.Context, req r
 		})
 	}
 
-	roleID, assigneeID := splitInternalID(state.ID)
+	roleID, assigneeID, diags := splitInternalID(state.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	if _, err := r.client.PermissionV1().RolePermission().Update(ctx, roleID, &permissionv1.UpdateRolePermissionsOptions{
 		Writes:  writes,
@@ -252,6 +280,7 @@ func (r *lakekeeperRoleRoleAssignmentResource) Update(ctx context.Context, req r
 	state.Assignments = plan.Assignments
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(roleRoleAssignmentIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Deletes removes the resource.
@@ -285,27 +314,64 @@ func (r *lakekeeperRoleRoleAssignmentResource) Delete(ctx context.Context, req r
 
 	if _, err := r.client.PermissionV1().RolePermission().Update(ctx, state.RoleID.ValueString(), &permissionv1.UpdateRolePermissionsOptions{
 		Deletes: deletes,
-	}); err != nil {
+	}); err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to delete role assignment, %v", err.Error()))
+		return
 	}
 
 	resp.State.RemoveResource(ctx)
 }
 
-// ImportState imports the resource into the Terraform state.
+// IdentitySchema defines the identity of the resource, used by import blocks.
+func (r *lakekeeperRoleRoleAssignmentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = roleRoleAssignmentIdentity.Schema()
+}
+
+// ImportState imports the resource into the Terraform state, either from its ID or from its identity.
 func (r *lakekeeperRoleRoleAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Expected format: "role_id/assingee_id"
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid import ID format",
-			"Expected format: role_id/assignee_id",
-		)
+	roleRoleAssignmentIdentity.ImportState(ctx, req, resp)
+}
+
+// lakekeeperRoleRoleAssignmentListModel describes the config data model of the list resource.
+type lakekeeperRoleRoleAssignmentListModel struct {
+	RoleID types.String `tfsdk:"role_id"`
+}
+
+// ListResourceConfigSchema defines the config of the list resource, used by list blocks.
+func (r *lakekeeperRoleRoleAssignmentResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the roles having assignments on a role.",
+		Attributes: map[string]listschema.Attribute{
+			"role_id": listschema.StringAttribute{
+				MarkdownDescription: "The ID of the role.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+// List lists the roles having assignments on a role.
+func (r *lakekeeperRoleRoleAssignmentResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config lakekeeperRoleRoleAssignmentListModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
 		return
 	}
 
-	resp.State.SetAttribute(ctx, path.Root("role_id"), parts[0])
-	resp.State.SetAttribute(ctx, path.Root("assignee_id"), parts[1])
+	roleID := config.RoleID.ValueString()
+
+	assignments, _, err := r.client.PermissionV1().RolePermission().GetAssignments(ctx, roleID, nil)
+	if err != nil {
+		diags.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read role assignments, %s", err.Error()))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var listed []listedResource
+	for _, id := range assignees(assignments.Assignments, permissionv1.RoleType) {
+		listed = append(listed, listedResource{ImportID: roleID + "/" + id, DisplayName: id})
+	}
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	stream.Results = listResults(ctx, req, r, listed)
 }
@@ -149,7 +149,10 @@ func testAccCheckLakekeeperRoleRoleAssignmentDestroy(s *terraform.State) error {
 			continue
 		}
 
-		roleID, assigneeID := splitInternalID(types.StringValue(rs.Primary.ID))
+		roleID, assigneeID, diags := splitInternalID(types.StringValue(rs.Primary.ID))
+		if diags.HasError() {
+			return fmt.Errorf("invalid resource ID %s", rs.Primary.ID)
+		}
 
 		assignments, _, err := testutil.TestLakekeeperClient.PermissionV1().RolePermission().GetAssignments(context.Background(), roleID, nil)
 		if err != nil {
@@ -13,7 +13,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccLakekeeperRole_basic(t *testing.T) {
@@ -183,13 +188,85 @@ func TestAccLakekeeperRole_project(t *testing.T) {
 	})
 }
 
+func TestAccLakekeeperRole_identity(t *testing.T) {
+
+	rName := acctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckLakekeeperRoleDestroy,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "lakekeeper_role" "foo" {
+				  name = "%s"
+				  project_id = "00000000-0000-0000-0000-000000000000"
+				}
+				`, rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("lakekeeper_role.foo", map[string]knownvalue.Check{
+						"project_id": knownvalue.StringExact("00000000-0000-0000-0000-000000000000"),
+						"role_id":    knownvalue.NotNull(),
+					}),
+				},
+			},
+			// Verify import with an invalid ID
+			{
+				ResourceName:  "lakekeeper_role.foo",
+				ImportState:   true,
+				ImportStateId: "invalid",
+				ExpectError:   regexp.MustCompile("Invalid import ID format"),
+			},
+			// Verify import with an identity
+			{
+				ResourceName:    "lakekeeper_role.foo",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccLakekeeperRole_disappears(t *testing.T) {
+
+	rName := acctest.RandString(8)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckLakekeeperRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "lakekeeper_role" "foo" {
+				  name = "%s"
+				  project_id = "00000000-0000-0000-0000-000000000000"
+				}
+				`, rName),
+				// the role is deleted outside of Terraform, it must be planned for creation
+				Check: func(s *terraform.State) error {
+					rs := s.RootModule().Resources["lakekeeper_role.foo"]
+					_, err := testutil.TestLakekeeperClient.RoleV1(rs.Primary.Attributes["project_id"]).Delete(context.Background(), rs.Primary.Attributes["role_id"])
+					return err
+				},
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func testAccCheckLakekeeperRoleDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "lakekeeper_role" {
 			continue
 		}
 
-		projectID, roleID := splitInternalID(types.StringValue(rs.Primary.ID))
+		projectID, roleID, diags := splitInternalID(types.StringValue(rs.Primary.ID))
+		if diags.HasError() {
+			return fmt.Errorf("invalid resource ID %s", rs.Primary.ID)
+		}
 
 		_, _, err := testutil.TestLakekeeperClient.RoleV1(projectID).Get(context.Background(), roleID)
 		if err == nil {
@@ -199,3 +276,63 @@ func testAccCheckLakekeeperRoleDestroy(s *terraform.State) error {
 	}
 	return nil
 }
+
+func TestAccLakekeeperRole_list(t *testing.T) {
+
+	project := testutil.CreateProject(t)
+	role1 := testutil.CreateRole(t, project.ID)
+	role2 := testutil.CreateRole(t, project.ID)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				data "lakekeeper_roles" "foo" {
+				  project_id = "%s"
+				}
+				`, project.ID),
+			},
+			{
+				Query: true,
+				Config: fmt.Sprintf(`
+				provider "lakekeeper" {}
+				list "lakekeeper_role" "all" {
+				  provider = lakekeeper
+
+				  config {
+				    project_id = "%s"
+				  }
+				}
+				list "lakekeeper_role" "by_name" {
+				  provider = lakekeeper
+
+				  config {
+				    project_id = "%s"
+				    name = "%s"
+				  }
+				}
+				`, project.ID, project.ID, role2.Name),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("lakekeeper_role.all", 2),
+					querycheck.ExpectIdentity("lakekeeper_role.all", map[string]knownvalue.Check{
+						"project_id": knownvalue.StringExact(project.ID),
+						"role_id":    knownvalue.StringExact(role1.ID),
+					}),
+					querycheck.ExpectIdentity("lakekeeper_role.all", map[string]knownvalue.Check{
+						"project_id": knownvalue.StringExact(project.ID),
+						"role_id":    knownvalue.StringExact(role2.ID),
+					}),
+					querycheck.ExpectLength("lakekeeper_role.by_name", 1),
+					querycheck.ExpectResourceDisplayName("lakekeeper_role.by_name", queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+						"project_id": knownvalue.StringExact(project.ID),
+						"role_id":    knownvalue.StringExact(role2.ID),
+					}), knownvalue.StringExact(role2.Name)),
+				},
+			},
+		},
+	})
+}
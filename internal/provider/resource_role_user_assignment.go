@@ -4,14 +4,14 @@ import (
 	"context"
 	"fmt"
 	"regexp"
-	"strings"
 
 	permissionv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1/permission"
 	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -25,10 +25,19 @@ var (
 	_ resource.Resource                = &lakekeeperRoleUserAssignmentResource{}
 	_ resource.ResourceWithConfigure   = &lakekeeperRoleUserAssignmentResource{}
 	_ resource.ResourceWithImportState = &lakekeeperRoleUserAssignmentResource{}
+	_ resource.ResourceWithIdentity    = &lakekeeperRoleUserAssignmentResource{}
+	_ list.ListResourceWithConfigure   = &lakekeeperRoleUserAssignmentResource{}
 )
 
+// roleUserAssignmentIdentity is the identity of the resource, the import ID is `{{role_id}}/{{user_id}}`.
+var roleUserAssignmentIdentity = resourceIdentity{
+	{Name: "role_id", Description: "The ID of the role."},
+	{Name: "user_id", Description: "The ID of the assigned user."},
+}
+
 func init() {
 	registerResource(NewLakekeeperRoleUserAssignment)
+	registerListResource(NewLakekeeperRoleUserAssignmentListResource)
 }
 
 // NewLakekeeperRoleAssignment is a helper function to simplify the provider implementation.
@@ -36,6 +45,11 @@ func NewLakekeeperRoleUserAssignment() resource.Resource {
 	return &lakekeeperRoleUserAssignmentResource{}
 }
 
+// NewLakekeeperRoleUserAssignmentListResource is a helper function to simplify the provider implementation.
+func NewLakekeeperRoleUserAssignmentListResource() list.ListResource {
+	return &lakekeeperRoleUserAssignmentResource{}
+}
+
 func (r *lakekeeperRoleUserAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_role_user_assignment"
 }
@@ -145,6 +159,7 @@ func (r *lakekeeperRoleUserAssignmentResource) Create(ctx context.Context, req r
 	state.Assignments = plan.Assignments
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(roleUserAssignmentIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -158,9 +173,17 @@ func (r *lakekeeperRoleUserAssignmentResource) Read(ctx context.Context, req res
 		return
 	}
 
-	roleID, userID := splitInternalID(state.ID)
+	roleID, userID, diags := splitInternalID(state.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	assignments, _, err := r.client.PermissionV1().RolePermission().GetAssignments(ctx, roleID, nil)
+	if isNotFoundError(err) {
+		removeMissingResource(ctx, roleUserAssignmentIdentity, req, resp)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read role assignments, %s", err.Error()))
 		return
@@ -184,6 +207,7 @@ func (r *lakekeeperRoleUserAssignmentResource) Read(ctx context.Context, req res
 
 	state.Assignments = newAssignments
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(roleUserAssignmentIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Updates updates the resource in-place.
@@ -235,7 +259,11 @@ func (r *lakekeeperRoleUserAssignmentResource) Update(ctx context.Context, req r
 		})
 	}
 
-	roleID, assigneeID := splitInternalID(state.ID)
+	roleID, assigneeID, diags := splitInternalID(state.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	if _, err := r.client.PermissionV1().RolePermission().Update(ctx, roleID, &permissionv1.UpdateRolePermissionsOptions{
 		Writes:  writes,
@@ -249,6 +277,7 @@ func (r *lakekeeperRoleUserAssignmentResource) Update(ctx context.Context, req r
 	state.Assignments = plan.Assignments
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(roleUserAssignmentIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Deletes removes the resource.
@@ -282,27 +311,64 @@ func (r *lakekeeperRoleUserAssignmentResource) Delete(ctx context.Context, req r
 
 	if _, err := r.client.PermissionV1().RolePermission().Update(ctx, state.RoleID.ValueString(), &permissionv1.UpdateRolePermissionsOptions{
 		Deletes: deletes,
-	}); err != nil {
+	}); err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to delete role assignment, %v", err.Error()))
+		return
 	}
 
 	resp.State.RemoveResource(ctx)
 }
 
-// ImportState imports the resource into the Terraform state.
+// IdentitySchema defines the identity of the resource, used by import blocks.
+func (r *lakekeeperRoleUserAssignmentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = roleUserAssignmentIdentity.Schema()
+}
+
+// ImportState imports the resource into the Terraform state, either from its ID or from its identity.
 func (r *lakekeeperRoleUserAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Expected format: "role_id/user_id"
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid import ID format",
-			"Expected format: role_id/user_id",
-		)
+	roleUserAssignmentIdentity.ImportState(ctx, req, resp)
+}
+
+// lakekeeperRoleUserAssignmentListModel describes the config data model of the list resource.
+type lakekeeperRoleUserAssignmentListModel struct {
+	RoleID types.String `tfsdk:"role_id"`
+}
+
+// ListResourceConfigSchema defines the config of the list resource, used by list blocks.
+func (r *lakekeeperRoleUserAssignmentResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the users having assignments on a role.",
+		Attributes: map[string]listschema.Attribute{
+			"role_id": listschema.StringAttribute{
+				MarkdownDescription: "The ID of the role.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+// List lists the users having assignments on a role.
+func (r *lakekeeperRoleUserAssignmentResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config lakekeeperRoleUserAssignmentListModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
 		return
 	}
 
-	resp.State.SetAttribute(ctx, path.Root("role_id"), parts[0])
-	resp.State.SetAttribute(ctx, path.Root("user_id"), parts[1])
+	roleID := config.RoleID.ValueString()
+
+	assignments, _, err := r.client.PermissionV1().RolePermission().GetAssignments(ctx, roleID, nil)
+	if err != nil {
+		diags.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read role assignments, %s", err.Error()))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var listed []listedResource
+	for _, id := range assignees(assignments.Assignments, permissionv1.UserType) {
+		listed = append(listed, listedResource{ImportID: roleID + "/" + id, DisplayName: id})
+	}
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	stream.Results = listResults(ctx, req, r, listed)
 }
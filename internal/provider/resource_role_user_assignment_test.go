@@ -142,7 +142,10 @@ func testAccCheckLakekeeperRoleUserAssignmentDestroy(s *terraform.State) error {
 			continue
 		}
 
-		roleID, userID := splitInternalID(types.StringValue(rs.Primary.ID))
+		roleID, userID, diags := splitInternalID(types.StringValue(rs.Primary.ID))
+		if diags.HasError() {
+			return fmt.Errorf("invalid resource ID %s", rs.Primary.ID)
+		}
 
 		assignments, _, err := testutil.TestLakekeeperClient.PermissionV1().RolePermission().GetAssignments(context.Background(), roleID, nil)
 		if err != nil {
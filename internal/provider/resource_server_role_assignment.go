@@ -9,7 +9,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -23,10 +25,18 @@ var (
 	_ resource.Resource                = &lakekeeperServerRoleAssignmentResource{}
 	_ resource.ResourceWithConfigure   = &lakekeeperServerRoleAssignmentResource{}
 	_ resource.ResourceWithImportState = &lakekeeperServerRoleAssignmentResource{}
+	_ resource.ResourceWithIdentity    = &lakekeeperServerRoleAssignmentResource{}
+	_ list.ListResourceWithConfigure   = &lakekeeperServerRoleAssignmentResource{}
 )
 
+// serverRoleAssignmentIdentity is the identity of the resource, the import ID is `{{role_id}}`.
+var serverRoleAssignmentIdentity = resourceIdentity{
+	{Name: "role_id", Description: "The ID of the assigned role."},
+}
+
 func init() {
 	registerResource(NewLakekeeperServerRoleAssignment)
+	registerListResource(NewLakekeeperServerRoleAssignmentListResource)
 }
 
 // NewLakekeeperServerAssignment is a helper function to simplify the provider implementation.
@@ -34,6 +44,11 @@ func NewLakekeeperServerRoleAssignment() resource.Resource {
 	return &lakekeeperServerRoleAssignmentResource{}
 }
 
+// NewLakekeeperServerRoleAssignmentListResource is a helper function to simplify the provider implementation.
+func NewLakekeeperServerRoleAssignmentListResource() list.ListResource {
+	return &lakekeeperServerRoleAssignmentResource{}
+}
+
 func (r *lakekeeperServerRoleAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_server_role_assignment"
 }
@@ -132,6 +147,7 @@ func (r *lakekeeperServerRoleAssignmentResource) Create(ctx context.Context, req
 	state.Assignments = plan.Assignments
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(serverRoleAssignmentIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -167,6 +183,7 @@ func (r *lakekeeperServerRoleAssignmentResource) Read(ctx context.Context, req r
 	state.ID = state.RoleID
 	state.Assignments = newAssignments
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(serverRoleAssignmentIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Updates updates the resource in-place.
@@ -229,6 +246,7 @@ func (r *lakekeeperServerRoleAssignmentResource) Update(ctx context.Context, req
 	state.Assignments = plan.Assignments
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(serverRoleAssignmentIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Deletes removes the resource.
@@ -269,7 +287,38 @@ func (r *lakekeeperServerRoleAssignmentResource) Delete(ctx context.Context, req
 	resp.State.RemoveResource(ctx)
 }
 
-// ImportState imports the resource into the Terraform state.
+// IdentitySchema defines the identity of the resource, used by import blocks.
+func (r *lakekeeperServerRoleAssignmentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = serverRoleAssignmentIdentity.Schema()
+}
+
+// ImportState imports the resource into the Terraform state, either from its ID or from its identity.
 func (r *lakekeeperServerRoleAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("role_id"), req, resp)
+	serverRoleAssignmentIdentity.ImportState(ctx, req, resp)
+}
+
+// ListResourceConfigSchema defines the config of the list resource, used by list blocks.
+func (r *lakekeeperServerRoleAssignmentResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the roles having assignments on the server.",
+	}
+}
+
+// List lists the roles having assignments on the server.
+func (r *lakekeeperServerRoleAssignmentResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+
+	assignments, _, err := r.client.PermissionV1().ServerPermission().GetAssignments(ctx, nil)
+	if err != nil {
+		diags.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read server assignments, %s", err.Error()))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var listed []listedResource
+	for _, id := range assignees(assignments.Assignments, permissionv1.RoleType) {
+		listed = append(listed, listedResource{ImportID: id, DisplayName: id})
+	}
+
+	stream.Results = listResults(ctx, req, r, listed)
 }
@@ -9,7 +9,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -23,10 +25,18 @@ var (
 	_ resource.Resource                = &lakekeeperServerUserAssignmentResource{}
 	_ resource.ResourceWithConfigure   = &lakekeeperServerUserAssignmentResource{}
 	_ resource.ResourceWithImportState = &lakekeeperServerUserAssignmentResource{}
+	_ resource.ResourceWithIdentity    = &lakekeeperServerUserAssignmentResource{}
+	_ list.ListResourceWithConfigure   = &lakekeeperServerUserAssignmentResource{}
 )
 
+// serverUserAssignmentIdentity is the identity of the resource, the import ID is `{{user_id}}`.
+var serverUserAssignmentIdentity = resourceIdentity{
+	{Name: "user_id", Description: "The ID of the assigned user."},
+}
+
 func init() {
 	registerResource(NewLakekeeperServerUserAssignment)
+	registerListResource(NewLakekeeperServerUserAssignmentListResource)
 }
 
 // NewLakekeeperServerAssignment is a helper function to simplify the provider implementation.
@@ -34,6 +44,11 @@ func NewLakekeeperServerUserAssignment() resource.Resource {
 	return &lakekeeperServerUserAssignmentResource{}
 }
 
+// NewLakekeeperServerUserAssignmentListResource is a helper function to simplify the provider implementation.
+func NewLakekeeperServerUserAssignmentListResource() list.ListResource {
+	return &lakekeeperServerUserAssignmentResource{}
+}
+
 func (r *lakekeeperServerUserAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_server_user_assignment"
 }
@@ -132,6 +147,7 @@ func (r *lakekeeperServerUserAssignmentResource) Create(ctx context.Context, req
 	state.Assignments = plan.Assignments
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(serverUserAssignmentIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -167,6 +183,7 @@ func (r *lakekeeperServerUserAssignmentResource) Read(ctx context.Context, req r
 	state.ID = state.UserID
 	state.Assignments = newAssignments
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(serverUserAssignmentIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Updates updates the resource in-place.
@@ -229,6 +246,7 @@ func (r *lakekeeperServerUserAssignmentResource) Update(ctx context.Context, req
 	state.Assignments = plan.Assignments
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(serverUserAssignmentIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Deletes removes the resource.
@@ -269,7 +287,38 @@ func (r *lakekeeperServerUserAssignmentResource) Delete(ctx context.Context, req
 	resp.State.RemoveResource(ctx)
 }
 
-// ImportState imports the resource into the Terraform state.
+// IdentitySchema defines the identity of the resource, used by import blocks.
+func (r *lakekeeperServerUserAssignmentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = serverUserAssignmentIdentity.Schema()
+}
+
+// ImportState imports the resource into the Terraform state, either from its ID or from its identity.
 func (r *lakekeeperServerUserAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("user_id"), req, resp)
+	serverUserAssignmentIdentity.ImportState(ctx, req, resp)
+}
+
+// ListResourceConfigSchema defines the config of the list resource, used by list blocks.
+func (r *lakekeeperServerUserAssignmentResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the users having assignments on the server.",
+	}
+}
+
+// List lists the users having assignments on the server.
+func (r *lakekeeperServerUserAssignmentResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+
+	assignments, _, err := r.client.PermissionV1().ServerPermission().GetAssignments(ctx, nil)
+	if err != nil {
+		diags.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read server assignments, %s", err.Error()))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var listed []listedResource
+	for _, id := range assignees(assignments.Assignments, permissionv1.UserType) {
+		listed = append(listed, listedResource{ImportID: id, DisplayName: id})
+	}
+
+	stream.Results = listResults(ctx, req, r, listed)
 }
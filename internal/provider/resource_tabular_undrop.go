@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	managementv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1"
+	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ resource.Resource              = &lakekeeperTabularUndropResource{}
+	_ resource.ResourceWithConfigure = &lakekeeperTabularUndropResource{}
+)
+
+func init() {
+	registerResource(NewLakekeeperTabularUndropResource)
+}
+
+// NewLakekeeperTabularUndropResource is a helper function to simplify the provider implementation.
+func NewLakekeeperTabularUndropResource() resource.Resource {
+	return &lakekeeperTabularUndropResource{}
+}
+
+func (r *lakekeeperTabularUndropResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tabular_undrop"
+}
+
+// lakekeeperTabularUndropResource defines the resource implementation.
+type lakekeeperTabularUndropResource struct {
+	client *lakekeeper.Client
+}
+
+// lakekeeperTabularUndropResourceModel describes the resource data model.
+type lakekeeperTabularUndropResourceModel struct {
+	ID          types.String                   `tfsdk:"id"`
+	ProjectID   types.String                   `tfsdk:"project_id"`
+	WarehouseID types.String                   `tfsdk:"warehouse_id"`
+	Tabulars    []lakekeeperUndropTabularModel `tfsdk:"tabulars"`
+	Triggers    types.Map                      `tfsdk:"triggers"`
+}
+
+// lakekeeperUndropTabularModel describes a table or view to restore.
+type lakekeeperUndropTabularModel struct {
+	ID   types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+}
+
+func (r *lakekeeperTabularUndropResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`lakekeeper_tabular_undrop`" + ` resource restores soft-deleted tables and views of a warehouse.
+
+The tables and views are restored when the resource is created. Any change of the arguments, including ` + "`triggers`" + `, replaces the resource and restores them again. Destroying this resource only removes it from the state, it does not drop the restored tables and views.
+
+Soft-deleted tables and views can be retrieved with the ` + "`lakekeeper_deleted_tabulars`" + ` data source.
+
+**Upstream API**: [Lakekeeper REST API docs](https://docs.lakekeeper.io/docs/nightly/api/management/#tag/warehouse/operation/undrop_tabulars)`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The internal ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The project ID to which the warehouse belongs.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"warehouse_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the warehouse.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile("^[^/]+$"), "must be a warehouse UUID and NOT include the project UUID"),
+				},
+			},
+			"tabulars": schema.SetNestedAttribute{
+				MarkdownDescription: "The tables and views to restore.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.Set{setplanmodifier.RequiresReplace()},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "ID of the table or view.",
+							Required:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: fmt.Sprintf("Either `%s` or `%s`.", managementv1.TableTabularType, managementv1.ViewTabularType),
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(string(managementv1.TableTabularType), string(managementv1.ViewTabularType)),
+							},
+						},
+					},
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values which, when changed, restores the tables and views again.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Map{mapplanmodifier.RequiresReplace()},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *lakekeeperTabularUndropResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceData := req.ProviderData.(*LakekeeperResourceData)
+	r.client = resourceData.Client
+}
+
+// Create restores the tables and views and adds the resource into the Terraform state.
+func (r *lakekeeperTabularUndropResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan lakekeeperTabularUndropResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := managementv1.UndropTabularOptions{}
+	for _, t := range plan.Tabulars {
+		opts.Targets = append(opts.Targets, struct {
+			ID   string                   `json:"id"`
+			Type managementv1.TabularType `json:"type"`
+		}{
+			ID:   t.ID.ValueString(),
+			Type: managementv1.TabularType(t.Type.ValueString()),
+		})
+	}
+
+	if _, err := r.client.WarehouseV1(plan.ProjectID.ValueString()).UndropTabular(ctx, plan.WarehouseID.ValueString(), &opts); err != nil {
+		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to undrop tabulars of warehouse %s, %v", plan.WarehouseID.ValueString(), err))
+		return
+	}
+
+	tflog.Debug(ctx, "restored soft-deleted tabulars", map[string]any{
+		"warehouse_id": plan.WarehouseID.ValueString(),
+		"count":        len(opts.Targets),
+	})
+
+	plan.ID = types.StringValue(uuid.NewString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the state as is, the restoration has no remote representation.
+func (r *lakekeeperTabularUndropResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state lakekeeperTabularUndropResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called, every configurable attribute requires a replacement.
+func (r *lakekeeperTabularUndropResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan lakekeeperTabularUndropResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the resource from the state, the restored tables and views are kept.
+func (r *lakekeeperTabularUndropResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
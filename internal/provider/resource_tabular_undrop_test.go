@@ -0,0 +1,97 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccLakekeeperTabularUndrop_mock(t *testing.T) {
+	var undropBodies []map[string]any
+
+	mockLakekeeperServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/token" && r.Method == "POST":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			// nolint - don't need to err check writing the response in the test
+			w.Write([]byte(`{"access_token": "SlAV32hkKG", "token_type": "Bearer", "expires_in": 3600}`))
+		case r.URL.Path == "/management/v1/warehouse/w1/deleted-tabulars/undrop" && r.Method == "POST":
+			if r.Header.Get("x-project-id") != "p1" {
+				t.Errorf("expected project header p1, got %q", r.Header.Get("x-project-id"))
+			}
+			var body map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("could not decode undrop request, %v", err)
+			}
+			undropBodies = append(undropBodies, body)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer mockLakekeeperServer.Close()
+
+	providerConfig := fmt.Sprintf(`
+		provider "lakekeeper" {
+			endpoint = "%s"
+			auth_url = "%s/token"
+			client_id = "test-id"
+			client_secret = "test-secret"
+		}
+		`, mockLakekeeperServer.URL, mockLakekeeperServer.URL)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				//lintignore:AT004 // Explicitly testing a provider configuration
+				Config: providerConfig + `
+				resource "lakekeeper_tabular_undrop" "test" {
+					project_id = "p1"
+					warehouse_id = "w1"
+					tabulars = [{ id = "t1", type = "table" }]
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("lakekeeper_tabular_undrop.test", "id"),
+					func(*terraform.State) error {
+						if len(undropBodies) != 1 {
+							return fmt.Errorf("expected 1 undrop request, got %d", len(undropBodies))
+						}
+						targets, _ := undropBodies[0]["targets"].([]any)
+						if len(targets) != 1 || fmt.Sprint(targets[0]) != "map[id:t1 type:table]" {
+							return fmt.Errorf("unexpected undrop request, got %v", undropBodies[0])
+						}
+						return nil
+					},
+				),
+			},
+			// a change of triggers restores again
+			{
+				//lintignore:AT004 // Explicitly testing a provider configuration
+				Config: providerConfig + `
+				resource "lakekeeper_tabular_undrop" "test" {
+					project_id = "p1"
+					warehouse_id = "w1"
+					tabulars = [{ id = "t1", type = "table" }]
+					triggers = { incident = "INC-42" }
+				}
+				`,
+				Check: func(*terraform.State) error {
+					if len(undropBodies) != 2 {
+						return fmt.Errorf("expected 2 undrop requests, got %d", len(undropBodies))
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
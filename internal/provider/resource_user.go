@@ -8,7 +8,8 @@ import (
 	managementv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1"
 	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -23,10 +24,18 @@ var (
 	_ resource.Resource                = &lakekeeperUserResource{}
 	_ resource.ResourceWithConfigure   = &lakekeeperUserResource{}
 	_ resource.ResourceWithImportState = &lakekeeperUserResource{}
+	_ resource.ResourceWithIdentity    = &lakekeeperUserResource{}
+	_ list.ListResourceWithConfigure   = &lakekeeperUserResource{}
 )
 
+// userIdentity is the identity of the resource, the import ID is `{{user_id}}`.
+var userIdentity = resourceIdentity{
+	{Name: "user_id", StateAttribute: "id", Description: "The ID of the user."},
+}
+
 func init() {
 	registerResource(NewLakekeeperUserResource)
+	registerListResource(NewLakekeeperUserListResource)
 }
 
 // NewLakekeeperUserResource is a helper function to simplify the provider implementation.
@@ -34,6 +43,11 @@ func NewLakekeeperUserResource() resource.Resource {
 	return &lakekeeperUserResource{}
 }
 
+// NewLakekeeperUserListResource is a helper function to simplify the provider implementation.
+func NewLakekeeperUserListResource() list.ListResource {
+	return &lakekeeperUserResource{}
+}
+
 func (r *lakekeeperUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_user"
 }
@@ -153,6 +167,7 @@ func (r *lakekeeperUserResource) Create(ctx context.Context, req resource.Create
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(userIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -169,6 +184,10 @@ func (r *lakekeeperUserResource) Read(ctx context.Context, req resource.ReadRequ
 	id := state.ID.ValueString()
 
 	user, _, err := r.client.UserV1().Get(ctx, id)
+	if isNotFoundError(err) {
+		removeMissingResource(ctx, userIdentity, req, resp)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read user %s, %v", id, err))
 		return
@@ -183,6 +202,7 @@ func (r *lakekeeperUserResource) Read(ctx context.Context, req resource.ReadRequ
 	state.LastUpdatedWith = types.StringValue(user.LastUpdatedWith)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(userIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Updates updates the resource in-place.
@@ -235,6 +255,7 @@ func (r *lakekeeperUserResource) Update(ctx context.Context, req resource.Update
 	state.LastUpdatedWith = types.StringValue(user.LastUpdatedWith)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(userIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Deletes removes the resource.
@@ -256,7 +277,7 @@ func (r *lakekeeperUserResource) Delete(ctx context.Context, req resource.Delete
 	}
 
 	_, err := r.client.UserV1().Delete(ctx, id)
-	if err != nil {
+	if err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to delete user %s, %v", id, err))
 		return
 	}
@@ -264,7 +285,56 @@ func (r *lakekeeperUserResource) Delete(ctx context.Context, req resource.Delete
 	resp.State.RemoveResource(ctx)
 }
 
-// ImportState imports the resource into the Terraform state.
+// IdentitySchema defines the identity of the resource, used by import blocks.
+func (r *lakekeeperUserResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = userIdentity.Schema()
+}
+
+// ImportState imports the resource into the Terraform state, either from its ID or from its identity.
 func (r *lakekeeperUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	userIdentity.ImportState(ctx, req, resp)
+}
+
+// lakekeeperUserListModel describes the config data model of the list resource.
+type lakekeeperUserListModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+// ListResourceConfigSchema defines the config of the list resource, used by list blocks.
+func (r *lakekeeperUserResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the users known to Lakekeeper.",
+		Attributes: map[string]listschema.Attribute{
+			"name": listschema.StringAttribute{
+				MarkdownDescription: "Only list the users whose name contains this string.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// List lists the users, following the pagination.
+func (r *lakekeeperUserResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config lakekeeperUserListModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	users, err := listUsers(ctx, r.client, &managementv1.ListUsersOptions{
+		Name: config.Name.ValueStringPointer(),
+	})
+	if err != nil {
+		diags.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to list users, %v", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var listed []listedResource
+	for _, u := range users {
+		listed = append(listed, listedResource{ImportID: u.ID, DisplayName: u.Name})
+	}
+
+	stream.Results = listResults(ctx, req, r, listed)
 }
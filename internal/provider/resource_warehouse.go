@@ -12,10 +12,14 @@ import (
 	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
 	"github.com/baptistegh/go-lakekeeper/pkg/core"
 
+	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/api"
 	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -23,10 +27,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/apache/iceberg-go/catalog/rest"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
@@ -34,10 +41,20 @@ var (
 	_ resource.Resource                = &lakekeeperWarehouseResource{}
 	_ resource.ResourceWithConfigure   = &lakekeeperWarehouseResource{}
 	_ resource.ResourceWithImportState = &lakekeeperWarehouseResource{}
+	_ resource.ResourceWithIdentity    = &lakekeeperWarehouseResource{}
+	_ list.ListResourceWithConfigure   = &lakekeeperWarehouseResource{}
+	_ resource.ResourceWithModifyPlan  = &lakekeeperWarehouseResource{}
 )
 
+// warehouseIdentity is the identity of the resource, the import ID is `{{project_id}}/{{warehouse_id}}`.
+var warehouseIdentity = resourceIdentity{
+	{Name: "project_id", Description: "The ID of the project the warehouse belongs to."},
+	{Name: "warehouse_id", Description: "The ID of the warehouse."},
+}
+
 func init() {
 	registerResource(NewLakekeeperWarehouseResource)
+	registerListResource(NewLakekeeperWarehouseListResource)
 }
 
 // NewLakekeeperWarehouseResource is a helper function to simplify the provider implementation.
@@ -45,13 +62,19 @@ func NewLakekeeperWarehouseResource() resource.Resource {
 	return &lakekeeperWarehouseResource{}
 }
 
+// NewLakekeeperWarehouseListResource is a helper function to simplify the provider implementation.
+func NewLakekeeperWarehouseListResource() list.ListResource {
+	return &lakekeeperWarehouseResource{}
+}
+
 func (r *lakekeeperWarehouseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_warehouse"
 }
 
 // lakekeeperWarehouseResource defines the resource implementation.
 type lakekeeperWarehouseResource struct {
-	client *lakekeeper.Client
+	client         *lakekeeper.Client
+	catalogOptions []rest.Option
 }
 
 type storageProfileWrapper struct {
@@ -62,15 +85,19 @@ type storageProfileWrapper struct {
 
 // lakekeeperWarehouseResourceModel describes the resource data model.
 type lakekeeperWarehouseResourceModel struct {
-	ID             types.String            `tfsdk:"id"` // form: project_id:warehouse_id (internal ID)
-	WarehouseID    types.String            `tfsdk:"warehouse_id"`
-	Name           types.String            `tfsdk:"name"`
-	ProjectID      types.String            `tfsdk:"project_id"`
-	Protected      types.Bool              `tfsdk:"protected"`
-	Active         types.Bool              `tfsdk:"active"`
-	ManagedAccess  types.Bool              `tfsdk:"managed_access"`
-	DeleteProfile  *sdk.DeleteProfileModel `tfsdk:"delete_profile"`
-	StorageProfile *storageProfileWrapper  `tfsdk:"storage_profile"`
+	ID                    types.String            `tfsdk:"id"` // form: project_id:warehouse_id (internal ID)
+	WarehouseID           types.String            `tfsdk:"warehouse_id"`
+	Name                  types.String            `tfsdk:"name"`
+	ProjectID             types.String            `tfsdk:"project_id"`
+	Protected             types.Bool              `tfsdk:"protected"`
+	Active                types.Bool              `tfsdk:"active"`
+	ManagedAccess         types.Bool              `tfsdk:"managed_access"`
+	CredentialVersion     types.Int64             `tfsdk:"credential_version"`
+	ValidateStorage       types.Bool              `tfsdk:"validate_storage"`
+	StorageChangeStrategy types.String            `tfsdk:"storage_change_strategy"`
+	ForceDestroy          types.Bool              `tfsdk:"force_destroy"`
+	DeleteProfile         *sdk.DeleteProfileModel `tfsdk:"delete_profile"`
+	StorageProfile        *storageProfileWrapper  `tfsdk:"storage_profile"`
 }
 
 func (r *lakekeeperWarehouseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -132,14 +159,45 @@ func (r *lakekeeperWarehouseResource) Schema(ctx context.Context, req resource.S
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"credential_version": schema.Int64Attribute{
+				MarkdownDescription: "Arbitrary version of the storage credential. Changing this value forces the storage credential to be sent again to Lakekeeper, even if it is unchanged in the configuration. Useful to rotate a credential that changed outside of Terraform, or to send the credential of an imported warehouse.",
+				Optional:            true,
+			},
+			"validate_storage": schema.BoolAttribute{
+				MarkdownDescription: "Whether Lakekeeper must validate the access to the storage during the plan, when the storage profile or its credential is created or changed. Default is `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"storage_change_strategy": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("What to do when the storage location changes (storage family, `key_prefix`, S3 `bucket` or `region`, GCS `bucket`, ADLS `account_name` or `filesystem`), which Lakekeeper cannot update in-place. "+
+					"`%s` fails the plan, `%s` recreates the warehouse and loses its content, `%s` tries an in-place update anyway. Default is `%s`.",
+					storageChangeStrategyFail, storageChangeStrategyReplace, storageChangeStrategyUpdate, storageChangeStrategyFail),
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(storageChangeStrategyFail),
+				Validators: []validator.String{
+					stringvalidator.OneOf(storageChangeStrategyFail, storageChangeStrategyReplace, storageChangeStrategyUpdate),
+				},
+			},
+			"force_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether the warehouse is deleted even when it is protected or not empty, its namespaces are dropped first along with their tables and views. An inactive warehouse is activated to drop its namespaces. Otherwise the destroy fails when the warehouse is protected or has namespaces. Default is `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"delete_profile": sdk.DeleteProfileResourceSchema(),
 			"storage_profile": schema.SingleNestedAttribute{
 				Required:            true,
 				MarkdownDescription: "Configure the storage profile. Only one Of `s3`, `adls` or `gcs` must be provided.",
+				PlanModifiers: []planmodifier.Object{
+					storageChangeStrategyModifier{},
+				},
 				Attributes: map[string]schema.Attribute{
 					"s3": schema.SingleNestedAttribute{
 						Optional:            true,
 						MarkdownDescription: `S3 storage profile. Suitable for AWS or any service compatible with the S3 API.`,
+						Validators:          []validator.Object{sdk.S3StorageProfileValidator()},
 						Attributes: map[string]schema.Attribute{
 							"region": schema.StringAttribute{
 								Required:            true,
@@ -154,7 +212,7 @@ func (r *lakekeeperWarehouseResource) Schema(ctx context.Context, req resource.S
 							},
 							"sts_enabled": schema.BoolAttribute{
 								Required:            true,
-								MarkdownDescription: "Whether to enable STS for S3 storage profile. Required if the storage type is `s3`. If enabled, the `sts_role_arn` or `assume_role_arn` must be provided.",
+								MarkdownDescription: "Whether to enable STS for S3 storage profile. Required if the storage type is `s3`. If enabled with the `aws` flavor, the `sts_role_arn` or `assume_role_arn` must be provided.",
 							},
 							"key_prefix": schema.StringAttribute{
 								Optional:            true,
@@ -228,8 +286,10 @@ func (r *lakekeeperWarehouseResource) Schema(ctx context.Context, req resource.S
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
-							}, "sts_role_arn": schema.StringAttribute{
-								Optional: true,
+							},
+							"sts_role_arn": schema.StringAttribute{
+								Optional:            true,
+								MarkdownDescription: "ARN of the role to assume when issuing STS tokens. Requires `sts_enabled`.",
 							},
 							"sts_token_validity_seconds": schema.Int64Attribute{
 								Optional: true,
@@ -244,7 +304,10 @@ func (r *lakekeeperWarehouseResource) Schema(ctx context.Context, req resource.S
 							},
 							"credential": schema.SingleNestedAttribute{
 								Required:            true,
-								MarkdownDescription: "Configure the credentials to access the S3 storage. Only one of `access_key`, `cloudflare_r2` or `aws_system_identity` must be provided. This is not available for imported resources.",
+								MarkdownDescription: "Configure the credentials to access the S3 storage. Only one of `access_key`, `cloudflare_r2` or `aws_system_identity` must be provided. The API never returns it: imported warehouses adopt it on the next apply, see `credential_version`.",
+								PlanModifiers: []planmodifier.Object{
+									importedCredentialModifier{},
+								},
 								Attributes: map[string]schema.Attribute{
 									"access_key": schema.SingleNestedAttribute{
 										Optional:            true,
@@ -256,9 +319,25 @@ func (r *lakekeeperWarehouseResource) Schema(ctx context.Context, req resource.S
 												MarkdownDescription: "The access key ID. Required for `aws-access-key` credentials.",
 											},
 											"secret_access_key": schema.StringAttribute{
-												Required:            true,
+												Optional:            true,
 												Sensitive:           true,
-												MarkdownDescription: "The secret access key. Required for `aws-access-key` credentials.",
+												MarkdownDescription: "The secret access key. Exactly one of `secret_access_key` or `secret_access_key_wo` must be provided.",
+												Validators: []validator.String{
+													stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("secret_access_key_wo")),
+												},
+											},
+											"secret_access_key_wo": schema.StringAttribute{
+												Optional:            true,
+												Sensitive:           true,
+												WriteOnly:           true,
+												MarkdownDescription: "The secret access key, as a write-only attribute. It is never stored in the state. Requires Terraform 1.11 or later.",
+											},
+											"secret_access_key_wo_version": schema.Int64Attribute{
+												Optional:            true,
+												MarkdownDescription: "Version of `secret_access_key_wo`. Change it to send a new value of `secret_access_key_wo` to Lakekeeper.",
+												Validators: []validator.Int64{
+													int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("secret_access_key_wo")),
+												},
 											},
 											"external_id": schema.StringAttribute{
 												Optional:            true,
@@ -311,6 +390,7 @@ func (r *lakekeeperWarehouseResource) Schema(ctx context.Context, req resource.S
 					"adls": schema.SingleNestedAttribute{
 						Optional:            true,
 						MarkdownDescription: "ADLS storage profile. Suitable for Azure Data Lake Storage Gen2.",
+						Validators:          []validator.Object{sdk.ADLSStorageProfileValidator()},
 						Attributes: map[string]schema.Attribute{
 							"account_name": schema.StringAttribute{
 								Required:            true,
@@ -364,16 +444,35 @@ func (r *lakekeeperWarehouseResource) Schema(ctx context.Context, req resource.S
 							},
 							"credential": schema.SingleNestedAttribute{
 								Required:            true,
-								MarkdownDescription: "Configure the credentials to access the ADLS storage. One of `shared_access_key`, `client_credentials` or `azure_system_identity` must be provided. This is not available for imported resources.",
+								MarkdownDescription: "Configure the credentials to access the ADLS storage. One of `shared_access_key`, `client_credentials` or `azure_system_identity` must be provided. The API never returns it: imported warehouses adopt it on the next apply, see `credential_version`.",
+								PlanModifiers: []planmodifier.Object{
+									importedCredentialModifier{},
+								},
 								Attributes: map[string]schema.Attribute{
 									"shared_access_key": schema.SingleNestedAttribute{
 										Optional:            true,
 										MarkdownDescription: "Authenticate to ADLS with Shared Access Key",
 										Attributes: map[string]schema.Attribute{
 											"key": schema.StringAttribute{
-												Required:            true,
+												Optional:            true,
+												Sensitive:           true,
+												MarkdownDescription: "The shared access key. Exactly one of `key` or `key_wo` must be provided.",
+												Validators: []validator.String{
+													stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("key_wo")),
+												},
+											},
+											"key_wo": schema.StringAttribute{
+												Optional:            true,
 												Sensitive:           true,
-												MarkdownDescription: "The shared access key. Required for `azure-shared-access-key` credentials.",
+												WriteOnly:           true,
+												MarkdownDescription: "The shared access key, as a write-only attribute. It is never stored in the state. Requires Terraform 1.11 or later.",
+											},
+											"key_wo_version": schema.Int64Attribute{
+												Optional:            true,
+												MarkdownDescription: "Version of `key_wo`. Change it to send a new value of `key_wo` to Lakekeeper.",
+												Validators: []validator.Int64{
+													int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("key_wo")),
+												},
 											},
 										},
 									},
@@ -386,8 +485,25 @@ func (r *lakekeeperWarehouseResource) Schema(ctx context.Context, req resource.S
 												Sensitive: true,
 											},
 											"client_secret": schema.StringAttribute{
-												Required:  true,
-												Sensitive: true,
+												Optional:            true,
+												Sensitive:           true,
+												MarkdownDescription: "The client secret. Exactly one of `client_secret` or `client_secret_wo` must be provided.",
+												Validators: []validator.String{
+													stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("client_secret_wo")),
+												},
+											},
+											"client_secret_wo": schema.StringAttribute{
+												Optional:            true,
+												Sensitive:           true,
+												WriteOnly:           true,
+												MarkdownDescription: "The client secret, as a write-only attribute. It is never stored in the state. Requires Terraform 1.11 or later.",
+											},
+											"client_secret_wo_version": schema.Int64Attribute{
+												Optional:            true,
+												MarkdownDescription: "Version of `client_secret_wo`. Change it to send a new value of `client_secret_wo` to Lakekeeper.",
+												Validators: []validator.Int64{
+													int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("client_secret_wo")),
+												},
 											},
 											"tenant_id": schema.StringAttribute{
 												Required:  true,
@@ -413,6 +529,7 @@ func (r *lakekeeperWarehouseResource) Schema(ctx context.Context, req resource.S
 					"gcs": schema.SingleNestedAttribute{
 						Optional:            true,
 						MarkdownDescription: "GCS storage profile. Designed for use with Google Cloud Storage",
+						Validators:          []validator.Object{sdk.GCSStorageProfileValidator()},
 						Attributes: map[string]schema.Attribute{
 							"bucket": schema.StringAttribute{
 								Required:            true,
@@ -427,16 +544,35 @@ func (r *lakekeeperWarehouseResource) Schema(ctx context.Context, req resource.S
 							},
 							"credential": schema.SingleNestedAttribute{
 								Required:            true,
-								MarkdownDescription: "Configure the credentials to access the GCS storage. One of `service_account_key` or `gcp_system_identity` must be provided. This is not available for imported resources.",
+								MarkdownDescription: "Configure the credentials to access the GCS storage. One of `service_account_key` or `gcp_system_identity` must be provided. The API never returns it: imported warehouses adopt it on the next apply, see `credential_version`.",
+								PlanModifiers: []planmodifier.Object{
+									importedCredentialModifier{},
+								},
 								Attributes: map[string]schema.Attribute{
 									"service_account_key": schema.SingleNestedAttribute{
 										Optional:            true,
 										MarkdownDescription: "Authenticate to the GCS bucket with a Service Account Key",
 										Attributes: map[string]schema.Attribute{
 											"key": schema.StringAttribute{
-												Required:            true,
+												Optional:            true,
+												Sensitive:           true,
+												MarkdownDescription: "The service account key, in JSON. Exactly one of `key` or `key_wo` must be provided.",
+												Validators: []validator.String{
+													stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("key_wo")),
+												},
+											},
+											"key_wo": schema.StringAttribute{
+												Optional:            true,
 												Sensitive:           true,
-												MarkdownDescription: "Required for `service-account-key` credentials.",
+												WriteOnly:           true,
+												MarkdownDescription: "The service account key in JSON, as a write-only attribute. It is never stored in the state. Requires Terraform 1.11 or later.",
+											},
+											"key_wo_version": schema.Int64Attribute{
+												Optional:            true,
+												MarkdownDescription: "Version of `key_wo`. Change it to send a new value of `key_wo` to Lakekeeper.",
+												Validators: []validator.Int64{
+													int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("key_wo")),
+												},
 											},
 										},
 									},
@@ -470,6 +606,80 @@ func (r *lakekeeperWarehouseResource) Configure(ctx context.Context, req resourc
 
 	resourceData := req.ProviderData.(*LakekeeperResourceData)
 	r.client = resourceData.Client
+	r.catalogOptions = resourceData.CatalogOptions
+}
+
+// ModifyPlan validates the storage profile and its credential against the server when `validate_storage` is set.
+func (r *lakekeeperWarehouseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy or when the provider is not configured yet.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var validate types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("validate_storage"), &validate)...)
+	if resp.Diagnostics.HasError() || !validate.ValueBool() {
+		return
+	}
+
+	// The storage can only be validated once all the configured values are known.
+	var configStorage types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("storage_profile"), &configStorage)...)
+	if resp.Diagnostics.HasError() || !isFullyKnown(ctx, configStorage) {
+		return
+	}
+
+	var plan, config lakekeeperWarehouseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state lakekeeperWarehouseResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		profileChanged, credentialChanged, err := plan.storageChanges(&state)
+		if err != nil || (!profileChanged && !credentialChanged) {
+			return
+		}
+	}
+
+	if err := plan.setWriteOnlyCredentials(&config); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("storage_profile"), "Invalid storage profile", err.Error())
+		return
+	}
+
+	opts, err := plan.toWarehouseCreateRequest()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("storage_profile"), "Invalid storage profile", err.Error())
+		return
+	}
+
+	projectID := ""
+	if !plan.ProjectID.IsUnknown() {
+		projectID = plan.ProjectID.ValueString()
+	}
+
+	if _, err := api.ValidateStorage(ctx, r.client, projectID, &api.ValidateStorageOptions{
+		StorageProfile:    opts.StorageProfile,
+		StorageCredential: &opts.StorageCredential,
+	}); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("storage_profile"),
+			"Storage validation failed",
+			fmt.Sprintf("Lakekeeper is unable to access the storage of warehouse %s, %v", plan.Name.ValueString(), err),
+		)
+	}
+}
+
+func isFullyKnown(ctx context.Context, v attr.Value) bool {
+	tv, err := v.ToTerraformValue(ctx)
+	return err == nil && tv.IsFullyKnown()
 }
 
 // Create creates a new upstream resources and adds it into the Terraform state.
@@ -491,6 +701,18 @@ func (r *lakekeeperWarehouseResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	// Write-only credentials are only available in the configuration
+	var config lakekeeperWarehouseResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := state.setWriteOnlyCredentials(&config); err != nil {
+		resp.Diagnostics.AddError("Error decoding state to model", fmt.Sprintf("Incorrect Warehouse creation request, %v", err))
+		return
+	}
+
 	opts, err := state.toWarehouseCreateRequest()
 	if err != nil {
 		resp.Diagnostics.AddError("Error decoding state to model", fmt.Sprintf("Incorrect Warehouse creation request, %v", err))
@@ -509,7 +731,7 @@ func (r *lakekeeperWarehouseResource) Create(ctx context.Context, req resource.C
 		_, _, err := r.client.WarehouseV1(state.ProjectID.ValueString()).SetWarehouseProtection(ctx, w.ID, &managementv1.SetProtectionOptions{Protected: state.Protected.ValueBool()})
 		if err != nil {
 			resp.Diagnostics.AddError("Lakekeeper API error occurred.",
-				fmt.Sprintf("Unable to set protection to %t for warehouse %s, %v", state.Protected.ValueBool(), w.ID, err),
+				fmt.Sprintf("Unable to set protection to %t for warehouse %s, %v", state.Protected.ValueBool(), w.ID, capabilityError(ctx, r.client, capabilityWarehouseProtection, err)),
 			)
 		}
 	}
@@ -537,7 +759,7 @@ func (r *lakekeeperWarehouseResource) Create(ctx context.Context, req resource.C
 		})
 		if err != nil {
 			resp.Diagnostics.AddError("Lakekeeper API error occurred",
-				fmt.Sprintf("Unable to set managed access, %v", err))
+				fmt.Sprintf("Unable to set managed access, %v", capabilityError(ctx, r.client, capabilityManagedAccess, err)))
 			return
 		}
 	}
@@ -549,6 +771,7 @@ func (r *lakekeeperWarehouseResource) Create(ctx context.Context, req resource.C
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(warehouseIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -562,14 +785,22 @@ func (r *lakekeeperWarehouseResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	projectID, warehouseID := splitInternalID(state.ID)
+	projectID, warehouseID, diags := splitInternalID(state.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	warehouse, _, err := r.client.WarehouseV1(projectID).Get(ctx, warehouseID)
+	if isNotFoundError(err) {
+		removeMissingResource(ctx, warehouseIdentity, req, resp)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read warehouse %s in project %s, %s", warehouseID, projectID, err))
 		return
 	}
 
-	diags := state.RefreshFromSettings(warehouse, nil)
+	diags = state.RefreshFromSettings(warehouse, nil)
 	if diags.HasError() {
 		resp.Diagnostics = append(resp.Diagnostics, diags...)
 		return
@@ -578,26 +809,50 @@ func (r *lakekeeperWarehouseResource) Read(ctx context.Context, req resource.Rea
 	// get managed access property
 	m, _, err := r.client.PermissionV1().WarehousePermission().GetAuthzProperties(ctx, warehouse.ID)
 	if err != nil {
-		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read warehouse %s authorization properties in project %s, %s", warehouseID, projectID, err))
+		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read warehouse %s authorization properties in project %s, %s", warehouseID, projectID, capabilityError(ctx, r.client, capabilityManagedAccess, err)))
+		return
 	}
 	state.ManagedAccess = types.BoolValue(m.ManagedAccess)
 
+	// the attribute is not known by Lakekeeper, it is null in the states written by previous versions
+	if state.ForceDestroy.IsNull() {
+		state.ForceDestroy = types.BoolValue(false)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(warehouseIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Updates updates the resource in-place.
 func (r *lakekeeperWarehouseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan, state lakekeeperWarehouseResourceModel
+	var plan, state, config lakekeeperWarehouseResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	projectID, warehouseID := splitInternalID(state.ID)
+	projectID, warehouseID, diags := splitInternalID(state.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Must be computed before adding write-only credentials to the plan
+	profileChanged, credentialChanged, err := plan.storageChanges(&state)
+	if err != nil {
+		resp.Diagnostics.AddError("Error decoding plan to model", fmt.Sprintf("Incorrect Warehouse update request, %v", err))
+		return
+	}
+
+	if err := plan.setWriteOnlyCredentials(&config); err != nil {
+		resp.Diagnostics.AddError("Error decoding plan to model", fmt.Sprintf("Incorrect Warehouse update request, %v", err))
+		return
+	}
 
 	if plan.Active.ValueBool() {
 		if _, err := r.client.WarehouseV1(projectID).Activate(ctx, warehouseID); err != nil {
@@ -624,7 +879,7 @@ func (r *lakekeeperWarehouseResource) Update(ctx context.Context, req resource.U
 	// Set warehouse protection if the protected field is different
 	if plan.Protected.ValueBool() != state.Protected.ValueBool() {
 		if _, _, err := r.client.WarehouseV1(projectID).SetWarehouseProtection(ctx, warehouseID, &managementv1.SetProtectionOptions{Protected: plan.Protected.ValueBool()}); err != nil {
-			resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to set protection for warehouse %s in project %s, %v", warehouseID, projectID, err))
+			resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to set protection for warehouse %s in project %s, %v", warehouseID, projectID, capabilityError(ctx, r.client, capabilityWarehouseProtection, err)))
 			return
 		}
 		state.Protected = plan.Protected
@@ -641,28 +896,41 @@ func (r *lakekeeperWarehouseResource) Update(ctx context.Context, req resource.U
 		if _, err := r.client.WarehouseV1(projectID).UpdateDeleteProfile(ctx, warehouseID, &managementv1.UpdateDeleteProfileOptions{
 			DeleteProfile: *opts.DeleteProfile,
 		}); err != nil {
-			resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to update delete profile for warehouse %s in project %s, %v", warehouseID, projectID, err))
+			resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to update delete profile for warehouse %s in project %s, %v", warehouseID, projectID, capabilityError(ctx, r.client, capabilityDeleteProfile, err)))
 			return
 		}
 	}
 
-	// Update the storage profile and its storage credential
-	if _, err := r.client.WarehouseV1(projectID).UpdateStorageProfile(ctx, warehouseID, &managementv1.UpdateStorageProfileOptions{
-		StorageProfile:    opts.StorageProfile,
-		StorageCredential: &opts.StorageCredential,
-	}); err != nil {
-		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to update storage profile for warehouse %s in project %s, %v", warehouseID, projectID, err))
-		return
+	switch {
+	case profileChanged:
+		// Update the storage profile and its storage credential
+		if _, err := r.client.WarehouseV1(projectID).UpdateStorageProfile(ctx, warehouseID, &managementv1.UpdateStorageProfileOptions{
+			StorageProfile:    opts.StorageProfile,
+			StorageCredential: &opts.StorageCredential,
+		}); err != nil {
+			resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to update storage profile for warehouse %s in project %s, %v", warehouseID, projectID, err))
+			return
+		}
+	case credentialChanged:
+		// Only rotate the storage credential, the storage profile is left untouched
+		if _, err := r.client.WarehouseV1(projectID).UpdateStorageCredential(ctx, warehouseID, &managementv1.UpdateStorageCredentialOptions{
+			StorageCredential: &opts.StorageCredential,
+		}); err != nil {
+			resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to update storage credential for warehouse %s in project %s, %v", warehouseID, projectID, err))
+			return
+		}
 	}
+	state.CredentialVersion = plan.CredentialVersion
 
 	// Update the authorization property
 	if _, err := r.client.PermissionV1().WarehousePermission().SetManagedAccess(ctx, warehouseID, &permissionv1.SetWarehouseManagedAccessOptions{
 		ManagedAccess: plan.ManagedAccess.ValueBool(),
 	}); err != nil {
-		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to set authorization properties for warehouse %s in project %s, %v", warehouseID, projectID, err))
+		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to set authorization properties for warehouse %s in project %s, %v", warehouseID, projectID, capabilityError(ctx, r.client, capabilityManagedAccess, err)))
 		return
 	}
 	state.ManagedAccess = plan.ManagedAccess
+	state.ForceDestroy = plan.ForceDestroy
 
 	// Refresh the state with the updated warehouse settings
 	warehouse, _, err := r.client.WarehouseV1(projectID).Get(ctx, warehouseID)
@@ -671,13 +939,14 @@ func (r *lakekeeperWarehouseResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
-	diags := state.RefreshFromSettings(warehouse, &plan)
+	diags = state.RefreshFromSettings(warehouse, &plan)
 	if diags.HasError() {
 		resp.Diagnostics = append(resp.Diagnostics, diags...)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(warehouseIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Deletes removes the resource.
@@ -691,13 +960,86 @@ func (r *lakekeeperWarehouseResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
-	projectID, warehouseID := splitInternalID(state.ID)
+	projectID, warehouseID, diags := splitInternalID(state.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var opts managementv1.DeleteWarehouseOptions
 
-	opts := managementv1.DeleteWarehouseOptions{
-		Force: core.Ptr(true),
+	warehouse, _, err := r.client.WarehouseV1(projectID).Get(ctx, warehouseID)
+	if isNotFoundError(err) {
+		resp.State.RemoveResource(ctx)
+		return
 	}
+	if err != nil {
+		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read warehouse %s in project %s, %v", warehouseID, projectID, err))
+		return
+	}
+
+	if state.ForceDestroy.ValueBool() {
+		// the namespaces of an inactive warehouse cannot be listed nor dropped
+		if !warehouse.IsActive() {
+			if _, err := r.client.WarehouseV1(projectID).Activate(ctx, warehouseID); err != nil {
+				resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to activate warehouse %s in project %s before dropping its namespaces, %v", warehouseID, projectID, err))
+				return
+			}
+		}
 
-	if _, err := r.client.WarehouseV1(projectID).Delete(ctx, warehouseID, &opts); err != nil {
+		cat, err := r.client.CatalogV1(ctx, projectID, warehouse.Name, r.catalogOptions...)
+		if err != nil {
+			resp.Diagnostics.AddError("Could not create the Iceberg Catalog client", fmt.Sprintf("Unable to initialize the client, %v", err.Error()))
+			return
+		}
+
+		namespaces, err := cat.ListNamespaces(ctx, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("REST Catalog API error occurred", fmt.Sprintf("Unable to list namespaces of warehouse %s, %v", warehouseID, err.Error()))
+			return
+		}
+
+		for _, ns := range namespaces {
+			if _, err := api.DropNamespace(ctx, r.client, warehouseID, ns, &api.DropNamespaceOptions{Force: true, Recursive: true}); err != nil && !isNotFoundError(err) {
+				resp.Diagnostics.AddError("REST Catalog API error occurred", fmt.Sprintf("Unable to delete namespace %s of warehouse %s, %v", strings.Join(ns, "."), warehouseID, err.Error()))
+				return
+			}
+		}
+
+		opts.Force = core.Ptr(true)
+	} else {
+		if warehouse.Protected {
+			resp.Diagnostics.AddError("Warehouse is protected",
+				fmt.Sprintf("Warehouse %s is protected from deletion. Set `protected` to false before destroying the warehouse, or set `force_destroy` to true.", warehouse.Name))
+			return
+		}
+
+		if warehouse.IsActive() {
+			cat, err := r.client.CatalogV1(ctx, projectID, warehouse.Name, r.catalogOptions...)
+			if err != nil {
+				resp.Diagnostics.AddError("Could not create the Iceberg Catalog client", fmt.Sprintf("Unable to initialize the client, %v", err.Error()))
+				return
+			}
+
+			namespaces, err := cat.ListNamespaces(ctx, nil)
+			if err != nil {
+				resp.Diagnostics.AddError("REST Catalog API error occurred", fmt.Sprintf("Unable to list namespaces of warehouse %s, %v", warehouseID, err.Error()))
+				return
+			}
+
+			if len(namespaces) > 0 {
+				names := make([]string, len(namespaces))
+				for i, ns := range namespaces {
+					names[i] = strings.Join(ns, ".")
+				}
+				resp.Diagnostics.AddError("Warehouse is not empty",
+					fmt.Sprintf("Warehouse %s still has %d namespace(s): %s. Drop them before destroying the warehouse, or set `force_destroy` to true.", warehouse.Name, len(names), strings.Join(names, ", ")))
+				return
+			}
+		}
+	}
+
+	if _, err := r.client.WarehouseV1(projectID).Delete(ctx, warehouseID, &opts); err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to delete warehouse %s in project %s, %v", warehouseID, projectID, err))
 		return
 	}
@@ -705,20 +1047,197 @@ func (r *lakekeeperWarehouseResource) Delete(ctx context.Context, req resource.D
 	resp.State.RemoveResource(ctx)
 }
 
-// ImportState imports the resource into the Terraform state.
+// IdentitySchema defines the identity of the resource, used by import blocks.
+func (r *lakekeeperWarehouseResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = warehouseIdentity.Schema()
+}
+
+// ImportState imports the resource into the Terraform state, either from its ID or from its identity.
 func (r *lakekeeperWarehouseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Expected format: "project_id/warehouse_id"
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid import ID format",
-			"Expected format: project_id/warehouse_id",
+	warehouseIdentity.ImportState(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.SetAttribute(ctx, path.Root("validate_storage"), false)
+	resp.State.SetAttribute(ctx, path.Root("storage_change_strategy"), storageChangeStrategyFail)
+	resp.State.SetAttribute(ctx, path.Root("force_destroy"), false)
+}
+
+const (
+	storageChangeStrategyFail    = "fail"
+	storageChangeStrategyReplace = "replace"
+	storageChangeStrategyUpdate  = "update"
+)
+
+// storageLocationAttributes are the attributes of each storage family
+// which cannot be updated in-place by Lakekeeper.
+var storageLocationAttributes = []struct {
+	family     string
+	attributes []string
+}{
+	{family: "s3", attributes: []string{"bucket", "region", "key_prefix"}},
+	{family: "adls", attributes: []string{"account_name", "filesystem", "key_prefix"}},
+	{family: "gcs", attributes: []string{"bucket", "key_prefix"}},
+}
+
+// storageChangeStrategyModifier applies `storage_change_strategy` when the storage location of the warehouse changes.
+type storageChangeStrategyModifier struct{}
+
+func (m storageChangeStrategyModifier) Description(ctx context.Context) string {
+	return "Applies the storage change strategy when the storage location of the warehouse changes."
+}
+
+func (m storageChangeStrategyModifier) MarkdownDescription(ctx context.Context) string {
+	return "Applies the `storage_change_strategy` when the storage location of the warehouse changes."
+}
+
+func (m storageChangeStrategyModifier) PlanModifyObject(ctx context.Context, req planmodifier.ObjectRequest, resp *planmodifier.ObjectResponse) {
+	// Nothing to compare on create or destroy.
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() {
+		return
+	}
+
+	changes := storageLocationChanges(req.StateValue, req.PlanValue)
+	if len(changes) == 0 {
+		return
+	}
+
+	var strategy types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("storage_change_strategy"), &strategy)...)
+	if resp.Diagnostics.HasError() || strategy.IsUnknown() {
+		return
+	}
+
+	switch strategy.ValueString() {
+	case storageChangeStrategyReplace:
+		resp.RequiresReplace = true
+	case storageChangeStrategyUpdate:
+		return
+	default:
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Storage location cannot be updated",
+			fmt.Sprintf("Lakekeeper cannot update the storage location of a warehouse in-place, changed: %s. "+
+				"Set `storage_change_strategy` to `%s` to recreate the warehouse, its content will be lost.", strings.Join(changes, ", "), storageChangeStrategyReplace),
 		)
+	}
+}
+
+// storageLocationChanges returns the storage location attributes which differ between both storage profiles.
+// Unknown values are considered as changes.
+func storageLocationChanges(state, plan types.Object) []string {
+	if plan.IsUnknown() {
+		return []string{"storage_profile"}
+	}
+
+	var changes []string
+	for _, l := range storageLocationAttributes {
+		stateFamily, _ := state.Attributes()[l.family].(types.Object)
+		planFamily, _ := plan.Attributes()[l.family].(types.Object)
+
+		if stateFamily.IsNull() && planFamily.IsNull() {
+			continue
+		}
+		if stateFamily.IsNull() || planFamily.IsNull() || planFamily.IsUnknown() {
+			changes = append(changes, l.family)
+			continue
+		}
+
+		for _, name := range l.attributes {
+			if !stateFamily.Attributes()[name].Equal(planFamily.Attributes()[name]) {
+				changes = append(changes, l.family+"."+name)
+			}
+		}
+	}
+
+	return changes
+}
+
+// importedCredentialModifier warns that the storage credential of an imported warehouse
+// is adopted from the configuration. The API never returns the credentials, so they are
+// missing from the state after an import.
+type importedCredentialModifier struct{}
+
+func (m importedCredentialModifier) Description(ctx context.Context) string {
+	return "Adopts the storage credential of the configuration for imported warehouses."
+}
+
+func (m importedCredentialModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m importedCredentialModifier) PlanModifyObject(ctx context.Context, req planmodifier.ObjectRequest, resp *planmodifier.ObjectResponse) {
+	// Only existing warehouses without credential in the state are concerned.
+	if req.State.Raw.IsNull() || !req.StateValue.IsNull() || req.PlanValue.IsNull() {
+		return
+	}
+
+	// The storage family is new, the credential is sent with the new storage profile.
+	var family types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, req.Path.ParentPath(), &family)...)
+	if resp.Diagnostics.HasError() || family.IsNull() {
 		return
 	}
 
-	resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])
-	resp.State.SetAttribute(ctx, path.Root("warehouse_id"), parts[1])
+	var planVersion, stateVersion types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("credential_version"), &planVersion)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("credential_version"), &stateVersion)...)
+	if resp.Diagnostics.HasError() || !planVersion.Equal(stateVersion) {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		req.Path,
+		"Storage credential adopted from the configuration",
+		"Lakekeeper never returns the storage credential, it is missing from the state of imported warehouses. "+
+			"The next apply only records the credential of the configuration in the state, without sending it to Lakekeeper. "+
+			"Change `credential_version` to also send it.",
+	)
+}
+
+// lakekeeperWarehouseListModel describes the config data model of the list resource.
+type lakekeeperWarehouseListModel struct {
+	ProjectID types.String `tfsdk:"project_id"`
+}
+
+// ListResourceConfigSchema defines the config of the list resource, used by list blocks.
+func (r *lakekeeperWarehouseResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the warehouses of a project, active or not.",
+		Attributes: map[string]listschema.Attribute{
+			"project_id": listschema.StringAttribute{
+				MarkdownDescription: "The ID of the project.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+// List lists the warehouses of the project, active or not.
+func (r *lakekeeperWarehouseResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config lakekeeperWarehouseListModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	projectID := config.ProjectID.ValueString()
+
+	warehouses, _, err := r.client.WarehouseV1(projectID).List(ctx, &managementv1.ListWarehouseOptions{
+		WarehouseStatus: []managementv1.WarehouseStatus{managementv1.WarehouseStatusActive, managementv1.WarehouseStatusInactive},
+	})
+	if err != nil {
+		diags.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to list warehouses in project %s, %v", projectID, err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var listed []listedResource
+	for _, w := range warehouses.Warehouses {
+		listed = append(listed, listedResource{ImportID: projectID + "/" + w.ID, DisplayName: w.Name})
+	}
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	stream.Results = listResults(ctx, req, r, listed)
 }
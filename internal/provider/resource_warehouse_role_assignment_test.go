@@ -153,7 +153,10 @@ func testAccCheckLakekeeperWarehouseRoleAssignmentDestroy(s *terraform.State) er
 			continue
 		}
 
-		warehouseID, roleID := splitInternalID(types.StringValue(rs.Primary.ID))
+		warehouseID, roleID, diags := splitInternalID(types.StringValue(rs.Primary.ID))
+		if diags.HasError() {
+			return fmt.Errorf("invalid resource ID %s", rs.Primary.ID)
+		}
 
 		assignments, _, err := testutil.TestLakekeeperClient.PermissionV1().WarehousePermission().GetAssignments(context.Background(), warehouseID, nil)
 		if err != nil {
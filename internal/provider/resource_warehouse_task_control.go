@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
+	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/api"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ resource.Resource                   = &lakekeeperWarehouseTaskControlResource{}
+	_ resource.ResourceWithConfigure      = &lakekeeperWarehouseTaskControlResource{}
+	_ resource.ResourceWithValidateConfig = &lakekeeperWarehouseTaskControlResource{}
+)
+
+func init() {
+	registerResource(NewLakekeeperWarehouseTaskControlResource)
+}
+
+// NewLakekeeperWarehouseTaskControlResource is a helper function to simplify the provider implementation.
+func NewLakekeeperWarehouseTaskControlResource() resource.Resource {
+	return &lakekeeperWarehouseTaskControlResource{}
+}
+
+func (r *lakekeeperWarehouseTaskControlResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_warehouse_task_control"
+}
+
+// lakekeeperWarehouseTaskControlResource defines the resource implementation.
+type lakekeeperWarehouseTaskControlResource struct {
+	client *lakekeeper.Client
+}
+
+// lakekeeperWarehouseTaskControlResourceModel describes the resource data model.
+type lakekeeperWarehouseTaskControlResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	WarehouseID  types.String `tfsdk:"warehouse_id"`
+	Action       types.String `tfsdk:"action"`
+	TaskIDs      types.Set    `tfsdk:"task_ids"`
+	ScheduledFor types.String `tfsdk:"scheduled_for"`
+	Triggers     types.Map    `tfsdk:"triggers"`
+}
+
+func (r *lakekeeperWarehouseTaskControlResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	actions := make([]string, len(api.ValidTaskControlActions))
+	for i, a := range api.ValidTaskControlActions {
+		actions[i] = string(a)
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`lakekeeper_warehouse_task_control`" + ` resource applies an action on tasks of a warehouse, e.g. to run a pending purge immediately or to cancel it.
+
+The action is sent when the resource is created. Any change of the arguments, including ` + "`triggers`" + `, replaces the resource and sends the action again. Destroying this resource only removes it from the state.
+
+Task IDs can be retrieved with the ` + "`lakekeeper_warehouse_tasks`" + ` data source.
+
+**Upstream API**: [Lakekeeper REST API docs](https://docs.lakekeeper.io/docs/nightly/api/management/#tag/tasks/operation/control_tasks)`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The internal ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"warehouse_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the warehouse.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile("^[^/]+$"), "must be a warehouse UUID and NOT include the project UUID"),
+				},
+			},
+			"action": schema.StringAttribute{
+				MarkdownDescription: "The action to apply on the tasks. Possible values are " + joinCode(actions) + ".",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.OneOf(actions...),
+				},
+			},
+			"task_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the tasks to apply the action on.",
+				ElementType:         types.StringType,
+				Required:            true,
+				PlanModifiers:       []planmodifier.Set{setplanmodifier.RequiresReplace()},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"scheduled_for": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("When the tasks must run, in RFC3339 format. Required when `action` is `%s`.", api.TaskControlActionRunAt),
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values which, when changed, sends the action again.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Map{mapplanmodifier.RequiresReplace()},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *lakekeeperWarehouseTaskControlResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceData := req.ProviderData.(*LakekeeperResourceData)
+	r.client = resourceData.Client
+}
+
+// ValidateConfig checks that scheduled_for is only set with the run-at action.
+func (r *lakekeeperWarehouseTaskControlResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config lakekeeperWarehouseTaskControlResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Action.IsUnknown() || config.ScheduledFor.IsUnknown() {
+		return
+	}
+
+	runAt := config.Action.ValueString() == string(api.TaskControlActionRunAt)
+
+	switch {
+	case runAt && config.ScheduledFor.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("scheduled_for"), "Missing attribute", fmt.Sprintf("`scheduled_for` is required when `action` is `%s`", api.TaskControlActionRunAt))
+	case !runAt && !config.ScheduledFor.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("scheduled_for"), "Invalid attribute combination", fmt.Sprintf("`scheduled_for` can only be set when `action` is `%s`", api.TaskControlActionRunAt))
+	case !config.ScheduledFor.IsNull():
+		if _, err := time.Parse(time.RFC3339, config.ScheduledFor.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("scheduled_for"), "Invalid date", fmt.Sprintf("must be in RFC3339 format, %v", err))
+		}
+	}
+}
+
+// Create sends the action to the server and adds the resource into the Terraform state.
+func (r *lakekeeperWarehouseTaskControlResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan lakekeeperWarehouseTaskControlResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var taskIDs []string
+	resp.Diagnostics.Append(plan.TaskIDs.ElementsAs(ctx, &taskIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := api.ControlTasksOptions{
+		Action: api.TaskControlAction{
+			ActionType: api.TaskControlActionType(plan.Action.ValueString()),
+		},
+		TaskIDs: taskIDs,
+	}
+
+	if !plan.ScheduledFor.IsNull() {
+		t, err := time.Parse(time.RFC3339, plan.ScheduledFor.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("scheduled_for"), "Invalid date", fmt.Sprintf("must be in RFC3339 format, %v", err))
+			return
+		}
+		opts.Action.ScheduledFor = &t
+	}
+
+	if _, err := api.ControlTasks(ctx, r.client, plan.WarehouseID.ValueString(), &opts); err != nil {
+		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to %s tasks of warehouse %s, %v", plan.Action.ValueString(), plan.WarehouseID.ValueString(), err))
+		return
+	}
+
+	tflog.Debug(ctx, "applied action on warehouse tasks", map[string]any{
+		"warehouse_id": plan.WarehouseID.ValueString(),
+		"action":       plan.Action.ValueString(),
+		"task_ids":     taskIDs,
+	})
+
+	plan.ID = types.StringValue(uuid.NewString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the state as is, the action has no remote representation.
+func (r *lakekeeperWarehouseTaskControlResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state lakekeeperWarehouseTaskControlResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called, every configurable attribute requires a replacement.
+func (r *lakekeeperWarehouseTaskControlResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan lakekeeperWarehouseTaskControlResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the resource from the state, the action can't be reverted.
+func (r *lakekeeperWarehouseTaskControlResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
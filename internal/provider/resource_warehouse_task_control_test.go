@@ -0,0 +1,110 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccLakekeeperWarehouseTaskControl_mock(t *testing.T) {
+	var controlBodies []map[string]any
+
+	mockLakekeeperServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/token" && r.Method == "POST":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			// nolint - don't need to err check writing the response in the test
+			w.Write([]byte(`{"access_token": "SlAV32hkKG", "token_type": "Bearer", "expires_in": 3600}`))
+		case r.URL.Path == "/management/v1/warehouse/w1/task/control" && r.Method == "POST":
+			var body map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("could not decode control request, %v", err)
+			}
+			controlBodies = append(controlBodies, body)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer mockLakekeeperServer.Close()
+
+	providerConfig := fmt.Sprintf(`
+		provider "lakekeeper" {
+			endpoint = "%s"
+			auth_url = "%s/token"
+			client_id = "test-id"
+			client_secret = "test-secret"
+		}
+		`, mockLakekeeperServer.URL, mockLakekeeperServer.URL)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				//lintignore:AT004 // Explicitly testing a provider configuration
+				Config: providerConfig + `
+				resource "lakekeeper_warehouse_task_control" "test" {
+					warehouse_id = "w1"
+					action = "run-at"
+					task_ids = ["t1"]
+				}
+				`,
+				ExpectError: regexp.MustCompile("`scheduled_for` is required when `action` is `run-at`"),
+			},
+			{
+				//lintignore:AT004 // Explicitly testing a provider configuration
+				Config: providerConfig + `
+				resource "lakekeeper_warehouse_task_control" "test" {
+					warehouse_id = "w1"
+					action = "run-now"
+					task_ids = ["t1", "t2"]
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("lakekeeper_warehouse_task_control.test", "id"),
+					resource.TestCheckResourceAttr("lakekeeper_warehouse_task_control.test", "task_ids.#", "2"),
+					func(*terraform.State) error {
+						if len(controlBodies) != 1 {
+							return fmt.Errorf("expected 1 control request, got %d", len(controlBodies))
+						}
+						action, _ := controlBodies[0]["action"].(map[string]any)
+						if action["action-type"] != "run-now" || fmt.Sprint(controlBodies[0]["task-ids"]) != "[t1 t2]" {
+							return fmt.Errorf("unexpected control request, got %v", controlBodies[0])
+						}
+						return nil
+					},
+				),
+			},
+			// changing the action sends it again
+			{
+				//lintignore:AT004 // Explicitly testing a provider configuration
+				Config: providerConfig + `
+				resource "lakekeeper_warehouse_task_control" "test" {
+					warehouse_id = "w1"
+					action = "cancel"
+					task_ids = ["t1", "t2"]
+				}
+				`,
+				Check: func(*terraform.State) error {
+					if len(controlBodies) != 2 {
+						return fmt.Errorf("expected 2 control requests, got %d", len(controlBodies))
+					}
+					action, _ := controlBodies[1]["action"].(map[string]any)
+					if action["action-type"] != "cancel" {
+						return fmt.Errorf("unexpected control request, got %v", controlBodies[1])
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,485 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
+	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ resource.Resource                   = &lakekeeperWarehouseTaskQueueConfigResource{}
+	_ resource.ResourceWithConfigure      = &lakekeeperWarehouseTaskQueueConfigResource{}
+	_ resource.ResourceWithImportState    = &lakekeeperWarehouseTaskQueueConfigResource{}
+	_ resource.ResourceWithIdentity       = &lakekeeperWarehouseTaskQueueConfigResource{}
+	_ resource.ResourceWithModifyPlan     = &lakekeeperWarehouseTaskQueueConfigResource{}
+	_ resource.ResourceWithValidateConfig = &lakekeeperWarehouseTaskQueueConfigResource{}
+)
+
+// taskQueueCommonSettings are the keys of the queue configuration which are common to all
+// the queues. They are managed by typed attributes instead of the raw `queue_config`.
+var taskQueueCommonSettings = map[string]string{
+	"max_retries":           "max-retries",
+	"num_workers":           "num-workers",
+	"poll_interval_seconds": "poll-interval-seconds",
+}
+
+// warehouseTaskQueueConfigIdentity is the identity of the resource, the import ID is `{{warehouse_id}}/{{queue_name}}`.
+var warehouseTaskQueueConfigIdentity = resourceIdentity{
+	{Name: "warehouse_id", Description: "The ID of the warehouse."},
+	{Name: "queue_name", Description: "The name of the task queue."},
+}
+
+func init() {
+	registerResource(NewLakekeeperWarehouseTaskQueueConfigResource)
+}
+
+// NewLakekeeperWarehouseTaskQueueConfigResource is a helper function to simplify the provider implementation.
+func NewLakekeeperWarehouseTaskQueueConfigResource() resource.Resource {
+	return &lakekeeperWarehouseTaskQueueConfigResource{}
+}
+
+func (r *lakekeeperWarehouseTaskQueueConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_warehouse_task_queue_config"
+}
+
+// lakekeeperWarehouseTaskQueueConfigResource defines the resource implementation.
+type lakekeeperWarehouseTaskQueueConfigResource struct {
+	client *lakekeeper.Client
+}
+
+// lakekeeperWarehouseTaskQueueConfigResourceModel describes the resource data model.
+type lakekeeperWarehouseTaskQueueConfigResourceModel struct {
+	ID                           types.String `tfsdk:"id"` // form: warehouse_id/queue_name (internal ID)
+	WarehouseID                  types.String `tfsdk:"warehouse_id"`
+	QueueName                    types.String `tfsdk:"queue_name"`
+	QueueConfig                  types.String `tfsdk:"queue_config"`
+	MaxSecondsSinceLastHeartbeat types.Int64  `tfsdk:"max_seconds_since_last_heartbeat"`
+	MaxRetries                   types.Int64  `tfsdk:"max_retries"`
+	NumWorkers                   types.Int64  `tfsdk:"num_workers"`
+	PollIntervalSeconds          types.Int64  `tfsdk:"poll_interval_seconds"`
+}
+
+func (r *lakekeeperWarehouseTaskQueueConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The ` + "`lakekeeper_warehouse_task_queue_config`" + ` resource configures a task queue for a warehouse.
+
+The available queues are reported by the ` + "`queues`" + ` attribute of the ` + "`lakekeeper_server_info`" + ` data source, e.g. ` + "`tabular_expiration`" + ` or ` + "`tabular_purge`" + `.
+Destroying this resource resets the queue to its default configuration.
+
+**Upstream API**: [Lakekeeper REST API docs](https://docs.lakekeeper.io/docs/nightly/api/management/#tag/tasks/operation/set_task_queue_config)`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The internal ID of this resource. In the form: `{{warehouse_id}}/{{queue_name}}`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"warehouse_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the warehouse.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile("^[^/]+$"), "must be a warehouse UUID and NOT include the project UUID"),
+				},
+			},
+			"queue_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the queue to configure. It must be one of the queues reported by the server.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile("^[^/]+$"), "must not include a slash"),
+				},
+			},
+			"queue_config": schema.StringAttribute{
+				MarkdownDescription: "The settings specific to the queue, as a JSON encoded object. The accepted keys depend on the queue, see the Lakekeeper documentation. The settings common to all the queues are set with their own attributes and must not be part of this object. Default is `{}`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("{}"),
+				Validators: []validator.String{
+					jsonObjectValidator{},
+				},
+			},
+			"max_seconds_since_last_heartbeat": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of seconds since the last heartbeat of a running task before it is considered dead and rescheduled. If not set, the server default is used.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of retries of a failed task before it is given up. If not set, the server default is used.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"num_workers": schema.Int64Attribute{
+				MarkdownDescription: "The number of tasks of the queue processed in parallel. If not set, the server default is used.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"poll_interval_seconds": schema.Int64Attribute{
+				MarkdownDescription: "The number of seconds between two polls of the queue for scheduled tasks. If not set, the server default is used.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *lakekeeperWarehouseTaskQueueConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	resourceData := req.ProviderData.(*LakekeeperResourceData)
+	r.client = resourceData.Client
+}
+
+// ValidateConfig checks that the common settings are not part of the raw queue configuration.
+func (r *lakekeeperWarehouseTaskQueueConfigResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var queueConfig types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("queue_config"), &queueConfig)...)
+	if resp.Diagnostics.HasError() || queueConfig.IsUnknown() || queueConfig.IsNull() {
+		return
+	}
+
+	var settings map[string]any
+	if err := json.Unmarshal([]byte(queueConfig.ValueString()), &settings); err != nil {
+		// reported by the attribute validator
+		return
+	}
+
+	for _, attribute := range slices.Sorted(maps.Keys(taskQueueCommonSettings)) {
+		if _, ok := settings[taskQueueCommonSettings[attribute]]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("queue_config"),
+				"Invalid queue configuration",
+				fmt.Sprintf("The setting %q must be set with the `%s` attribute.", taskQueueCommonSettings[attribute], attribute),
+			)
+		}
+	}
+}
+
+// ModifyPlan validates the queue name against the queues reported by the server.
+func (r *lakekeeperWarehouseTaskQueueConfigResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy or when the provider is not configured yet.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var queueName types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("queue_name"), &queueName)...)
+	if resp.Diagnostics.HasError() || queueName.IsUnknown() || queueName.IsNull() {
+		return
+	}
+
+	info, _, err := r.client.ServerV1().Info(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read server infos, %v", err))
+		return
+	}
+
+	if !slices.Contains(info.Queues, queueName.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("queue_name"),
+			"Unknown task queue",
+			fmt.Sprintf("The queue %q is not available on the Lakekeeper server, available queues are: %s", queueName.ValueString(), strings.Join(info.Queues, ", ")),
+		)
+	}
+}
+
+// Create creates a new upstream resources and adds it into the Terraform state.
+func (r *lakekeeperWarehouseTaskQueueConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan lakekeeperWarehouseTaskQueueConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, err := plan.toSetOptions()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("queue_config"), "Invalid queue configuration", err.Error())
+		return
+	}
+
+	if _, err := api.SetTaskQueueConfig(ctx, r.client, plan.WarehouseID.ValueString(), plan.QueueName.ValueString(), opts); err != nil {
+		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to configure task queue %s for warehouse %s, %v", plan.QueueName.ValueString(), plan.WarehouseID.ValueString(), err))
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s", plan.WarehouseID.ValueString(), plan.QueueName.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(warehouseTaskQueueConfigIdentity.Set(ctx, resp.State, resp.Identity)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *lakekeeperWarehouseTaskQueueConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state lakekeeperWarehouseTaskQueueConfigResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	warehouseID, queueName, diags := splitInternalID(state.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, _, err := api.GetTaskQueueConfig(ctx, r.client, warehouseID, queueName)
+	if isNotFoundError(err) {
+		removeMissingResource(ctx, warehouseTaskQueueConfigIdentity, req, resp)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read task queue %s for warehouse %s, %v", queueName, warehouseID, err))
+		return
+	}
+
+	state.WarehouseID = types.StringValue(warehouseID)
+	state.QueueName = types.StringValue(queueName)
+	state.MaxSecondsSinceLastHeartbeat = types.Int64PointerValue(config.MaxSecondsSinceLastHeartbeat)
+
+	if err := state.fromQueueConfig(config.QueueConfig); err != nil {
+		resp.Diagnostics.AddError("Error decoding the queue configuration", fmt.Sprintf("Unable to decode the configuration of task queue %s for warehouse %s, %v", queueName, warehouseID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(warehouseTaskQueueConfigIdentity.Set(ctx, resp.State, resp.Identity)...)
+}
+
+// Updates updates the resource in-place.
+func (r *lakekeeperWarehouseTaskQueueConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state lakekeeperWarehouseTaskQueueConfigResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	warehouseID, queueName, diags := splitInternalID(state.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, err := plan.toSetOptions()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("queue_config"), "Invalid queue configuration", err.Error())
+		return
+	}
+
+	if _, err := api.SetTaskQueueConfig(ctx, r.client, warehouseID, queueName, opts); err != nil {
+		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to update task queue %s for warehouse %s, %v", queueName, warehouseID, err))
+		return
+	}
+
+	plan.ID = state.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(warehouseTaskQueueConfigIdentity.Set(ctx, resp.State, resp.Identity)...)
+}
+
+// Deletes resets the queue configuration.
+func (r *lakekeeperWarehouseTaskQueueConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state lakekeeperWarehouseTaskQueueConfigResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	warehouseID, queueName, diags := splitInternalID(state.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := api.SetTaskQueueConfig(ctx, r.client, warehouseID, queueName, &api.SetTaskQueueConfigOptions{
+		QueueConfig: json.RawMessage("{}"),
+	}); err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to reset task queue %s for warehouse %s, %v", queueName, warehouseID, err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// IdentitySchema defines the identity of the resource, used by import blocks.
+func (r *lakekeeperWarehouseTaskQueueConfigResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = warehouseTaskQueueConfigIdentity.Schema()
+}
+
+// ImportState imports the resource into the Terraform state, either from its ID or from its identity.
+func (r *lakekeeperWarehouseTaskQueueConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	warehouseTaskQueueConfigIdentity.ImportState(ctx, req, resp)
+}
+
+func (m *lakekeeperWarehouseTaskQueueConfigResourceModel) toSetOptions() (*api.SetTaskQueueConfigOptions, error) {
+	settings := map[string]any{}
+	if err := json.Unmarshal([]byte(m.QueueConfig.ValueString()), &settings); err != nil {
+		return nil, err
+	}
+
+	for key, value := range m.commonSettings() {
+		if !value.IsNull() {
+			settings[key] = value.ValueInt64()
+		}
+	}
+
+	queueConfig, err := json.Marshal(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.SetTaskQueueConfigOptions{
+		QueueConfig:                  queueConfig,
+		MaxSecondsSinceLastHeartbeat: m.MaxSecondsSinceLastHeartbeat.ValueInt64Pointer(),
+	}, nil
+}
+
+// fromQueueConfig splits the queue configuration returned by the server into the
+// common settings and the settings specific to the queue.
+func (m *lakekeeperWarehouseTaskQueueConfigResourceModel) fromQueueConfig(queueConfig json.RawMessage) error {
+	settings := map[string]any{}
+	if len(queueConfig) > 0 {
+		decoder := json.NewDecoder(bytes.NewReader(queueConfig))
+		decoder.UseNumber()
+		if err := decoder.Decode(&settings); err != nil {
+			return err
+		}
+	}
+	if settings == nil {
+		// the configuration is null
+		settings = map[string]any{}
+	}
+
+	values := map[string]types.Int64{}
+	for key := range m.commonSettings() {
+		values[key] = types.Int64Null()
+
+		value, ok := settings[key]
+		if !ok {
+			continue
+		}
+		number, ok := value.(json.Number)
+		if !ok {
+			return fmt.Errorf("the setting %q is not a number", key)
+		}
+		i, err := number.Int64()
+		if err != nil {
+			return fmt.Errorf("the setting %q is not an integer, %w", key, err)
+		}
+		values[key] = types.Int64Value(i)
+		delete(settings, key)
+	}
+
+	m.MaxRetries = values[taskQueueCommonSettings["max_retries"]]
+	m.NumWorkers = values[taskQueueCommonSettings["num_workers"]]
+	m.PollIntervalSeconds = values[taskQueueCommonSettings["poll_interval_seconds"]]
+
+	specific, err := json.Marshal(settings)
+	if err != nil {
+		return err
+	}
+
+	// keep the configured formatting when the configuration did not change
+	if !jsonEqual(m.QueueConfig.ValueString(), specific) {
+		m.QueueConfig = types.StringValue(string(specific))
+	}
+
+	return nil
+}
+
+// commonSettings returns the common settings of the model, by key of the queue configuration.
+func (m *lakekeeperWarehouseTaskQueueConfigResourceModel) commonSettings() map[string]types.Int64 {
+	return map[string]types.Int64{
+		taskQueueCommonSettings["max_retries"]:           m.MaxRetries,
+		taskQueueCommonSettings["num_workers"]:           m.NumWorkers,
+		taskQueueCommonSettings["poll_interval_seconds"]: m.PollIntervalSeconds,
+	}
+}
+
+// jsonEqual returns true if both JSON documents are semantically equal.
+func jsonEqual(a string, b []byte) bool {
+	var va, vb any
+	if err := json.Unmarshal([]byte(a), &va); err != nil {
+		return false
+	}
+	if err := json.Unmarshal(b, &vb); err != nil {
+		return false
+	}
+
+	ca, err := json.Marshal(va)
+	if err != nil {
+		return false
+	}
+	cb, err := json.Marshal(vb)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(ca, cb)
+}
+
+// jsonObjectValidator validates that a string is a JSON encoded object.
+type jsonObjectValidator struct{}
+
+func (v jsonObjectValidator) Description(ctx context.Context) string {
+	return "value must be a JSON encoded object"
+}
+
+func (v jsonObjectValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonObjectValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var obj map[string]any
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &obj); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid JSON object", fmt.Sprintf("%s: %v", v.Description(ctx), err))
+	}
+}
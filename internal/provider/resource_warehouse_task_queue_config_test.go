@@ -0,0 +1,140 @@
+//go:build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"testing"
+
+	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/testutil"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLakekeeperWarehouseTaskQueueConfig_basic(t *testing.T) {
+	project := testutil.CreateProject(t)
+
+	keyPrefix := fmt.Sprintf("key-prefix-%d", rand.Int())
+	warehouse := testutil.CreateWarehouse(t, project.ID, keyPrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "lakekeeper_warehouse_task_queue_config" "test" {
+						warehouse_id = "%s"
+						queue_name = "tabular_expiration"
+					}
+				`, warehouse.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakekeeper_warehouse_task_queue_config.test", "id", warehouse.ID+"/tabular_expiration"),
+					resource.TestCheckResourceAttr("lakekeeper_warehouse_task_queue_config.test", "warehouse_id", warehouse.ID),
+					resource.TestCheckResourceAttr("lakekeeper_warehouse_task_queue_config.test", "queue_name", "tabular_expiration"),
+					resource.TestCheckResourceAttr("lakekeeper_warehouse_task_queue_config.test", "queue_config", "{}"),
+					resource.TestCheckNoResourceAttr("lakekeeper_warehouse_task_queue_config.test", "max_seconds_since_last_heartbeat"),
+					resource.TestCheckNoResourceAttr("lakekeeper_warehouse_task_queue_config.test", "max_retries"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "lakekeeper_warehouse_task_queue_config.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// set the heartbeat
+			{
+				Config: fmt.Sprintf(`
+					resource "lakekeeper_warehouse_task_queue_config" "test" {
+						warehouse_id = "%s"
+						queue_name = "tabular_expiration"
+						queue_config = jsonencode({})
+						max_seconds_since_last_heartbeat = 3600
+					}
+				`, warehouse.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakekeeper_warehouse_task_queue_config.test", "queue_config", "{}"),
+					resource.TestCheckResourceAttr("lakekeeper_warehouse_task_queue_config.test", "max_seconds_since_last_heartbeat", "3600"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "lakekeeper_warehouse_task_queue_config.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// set the common settings
+			{
+				Config: fmt.Sprintf(`
+					resource "lakekeeper_warehouse_task_queue_config" "test" {
+						warehouse_id = "%s"
+						queue_name = "tabular_expiration"
+						max_retries = 5
+						num_workers = 2
+						poll_interval_seconds = 30
+					}
+				`, warehouse.ID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakekeeper_warehouse_task_queue_config.test", "queue_config", "{}"),
+					resource.TestCheckResourceAttr("lakekeeper_warehouse_task_queue_config.test", "max_retries", "5"),
+					resource.TestCheckResourceAttr("lakekeeper_warehouse_task_queue_config.test", "num_workers", "2"),
+					resource.TestCheckResourceAttr("lakekeeper_warehouse_task_queue_config.test", "poll_interval_seconds", "30"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "lakekeeper_warehouse_task_queue_config.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLakekeeperWarehouseTaskQueueConfig_validation(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "lakekeeper_warehouse_task_queue_config" "test" {
+						warehouse_id = "a4653498-1dd9-4f12-a2e4-1cc7d4023226"
+						queue_name = "not_a_queue"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Unknown task queue`),
+			},
+			{
+				Config: `
+					resource "lakekeeper_warehouse_task_queue_config" "test" {
+						warehouse_id = "a4653498-1dd9-4f12-a2e4-1cc7d4023226"
+						queue_name = "tabular_expiration"
+						queue_config = "[]"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid JSON object`),
+			},
+			{
+				Config: `
+					resource "lakekeeper_warehouse_task_queue_config" "test" {
+						warehouse_id = "a4653498-1dd9-4f12-a2e4-1cc7d4023226"
+						queue_name = "tabular_expiration"
+						queue_config = jsonencode({ "max-retries" = 5 })
+					}
+				`,
+				ExpectError: regexp.MustCompile(`must be set with the .max_retries. attribute`),
+			},
+			{
+				Config: `
+					resource "lakekeeper_warehouse_task_queue_config" "test" {
+						warehouse_id = "a4653498-1dd9-4f12-a2e4-1cc7d4023226"
+						queue_name = "tabular_expiration"
+						num_workers = 0
+					}
+				`,
+				ExpectError: regexp.MustCompile(`num_workers`),
+			},
+		},
+	})
+}
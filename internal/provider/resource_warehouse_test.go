@@ -4,15 +4,25 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"testing"
 
+	"github.com/apache/iceberg-go/catalog"
+	managementv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1"
+	"github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1/storage/credential"
+	"github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1/storage/profile"
+	"github.com/baptistegh/go-lakekeeper/pkg/core"
 	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/testutil"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccLakekeeperWarehouse_basic(t *testing.T) {
@@ -125,6 +135,475 @@ func TestAccLakekeeperWarehouse_basic(t *testing.T) {
 	})
 }
 
+func TestAccLakekeeperWarehouse_CredentialRotation(t *testing.T) {
+
+	rName := acctest.RandString(8)
+
+	project := testutil.CreateProject(t)
+
+	config := `
+				resource "lakekeeper_warehouse" "s3" {
+					name = "%s"
+					project_id = "%s"
+					credential_version = %d
+					storage_profile = {
+						s3 = {
+							bucket = "testacc"
+							endpoint = "http://minio:9000/"
+							region = "eu-west-1"
+							sts_enabled = false
+							remote_signing_url_style = "path"
+							credential = {
+								access_key = {
+									access_key_id = "minio-root-user"
+									secret_access_key = "%s"
+								}
+							}
+						}
+					}
+				}
+				`
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckLakekeeperWarehouseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(config, rName, project.ID, 1, "minio-root-password"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakekeeper_warehouse.s3", "credential_version", "1"),
+					resource.TestCheckResourceAttr("lakekeeper_warehouse.s3", "storage_profile.s3.credential.access_key.secret_access_key", "minio-root-password"),
+				),
+			},
+			// Only the credential changes
+			{
+				Config: fmt.Sprintf(config, rName, project.ID, 1, "minio-root-password-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakekeeper_warehouse.s3", "credential_version", "1"),
+					resource.TestCheckResourceAttr("lakekeeper_warehouse.s3", "storage_profile.s3.bucket", "testacc"),
+					resource.TestCheckResourceAttr("lakekeeper_warehouse.s3", "storage_profile.s3.credential.access_key.secret_access_key", "minio-root-password-1"),
+				),
+			},
+			// Force the rotation of an unchanged credential
+			{
+				Config: fmt.Sprintf(config, rName, project.ID, 2, "minio-root-password-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakekeeper_warehouse.s3", "credential_version", "2"),
+					resource.TestCheckResourceAttr("lakekeeper_warehouse.s3", "storage_profile.s3.credential.access_key.secret_access_key", "minio-root-password-1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLakekeeperWarehouse_WriteOnlyCredential(t *testing.T) {
+
+	rName := acctest.RandString(8)
+
+	project := testutil.CreateProject(t)
+
+	config := `
+				resource "lakekeeper_warehouse" "s3" {
+					name = "%s"
+					project_id = "%s"
+					storage_profile = {
+						s3 = {
+							bucket = "testacc"
+							endpoint = "http://minio:9000/"
+							region = "eu-west-1"
+							sts_enabled = false
+							remote_signing_url_style = "path"
+							credential = {
+								access_key = {
+									access_key_id = "minio-root-user"
+									secret_access_key_wo = "%s"
+									secret_access_key_wo_version = %d
+								}
+							}
+						}
+					}
+				}
+				`
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckLakekeeperWarehouseDestroy,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(config, rName, project.ID, "minio-root-password", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakekeeper_warehouse.s3", "storage_profile.s3.credential.access_key.access_key_id", "minio-root-user"),
+					resource.TestCheckResourceAttr("lakekeeper_warehouse.s3", "storage_profile.s3.credential.access_key.secret_access_key_wo_version", "1"),
+					resource.TestCheckNoResourceAttr("lakekeeper_warehouse.s3", "storage_profile.s3.credential.access_key.secret_access_key_wo"),
+					resource.TestCheckNoResourceAttr("lakekeeper_warehouse.s3", "storage_profile.s3.credential.access_key.secret_access_key"),
+				),
+			},
+			// Bump the version to send the new secret
+			{
+				Config: fmt.Sprintf(config, rName, project.ID, "minio-root-password-1", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakekeeper_warehouse.s3", "storage_profile.s3.credential.access_key.secret_access_key_wo_version", "2"),
+					resource.TestCheckNoResourceAttr("lakekeeper_warehouse.s3", "storage_profile.s3.credential.access_key.secret_access_key_wo"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLakekeeperWarehouse_ValidateStorage_mock(t *testing.T) {
+	var validationBodies []map[string]any
+
+	mockLakekeeperServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/token" && r.Method == "POST":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			// nolint - don't need to err check writing the response in the test
+			w.Write([]byte(`{"access_token": "SlAV32hkKG", "token_type": "Bearer", "expires_in": 3600}`))
+		case r.URL.Path == "/management/v1/validate-storage" && r.Method == "POST":
+			var body map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("could not decode validation request, %v", err)
+			}
+			validationBodies = append(validationBodies, body)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			// nolint - don't need to err check writing the response in the test
+			w.Write([]byte(`{"error": {"code": 400, "message": "Access Denied to bucket testacc", "type": "ValidationFailed"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer mockLakekeeperServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				//lintignore:AT004 // Explicitly testing a provider configuration
+				Config: fmt.Sprintf(`
+				provider "lakekeeper" {
+					endpoint = "%s"
+					auth_url = "%s/token"
+					client_id = "test-id"
+					client_secret = "test-secret"
+				}
+
+				resource "lakekeeper_warehouse" "s3" {
+					name = "test"
+					project_id = "p1"
+					validate_storage = true
+					storage_profile = {
+						s3 = {
+							bucket = "testacc"
+							region = "eu-west-1"
+							sts_enabled = false
+							credential = {
+								access_key = {
+									access_key_id = "access-key"
+									secret_access_key = "secret-key"
+								}
+							}
+						}
+					}
+				}
+				`, mockLakekeeperServer.URL, mockLakekeeperServer.URL),
+				ExpectError: regexp.MustCompile("Storage validation failed"),
+			},
+		},
+		CheckDestroy: func(*terraform.State) error {
+			if len(validationBodies) == 0 {
+				return fmt.Errorf("expected a storage validation request")
+			}
+			storageProfile, _ := validationBodies[0]["storage-profile"].(map[string]any)
+			if storageProfile["bucket"] != "testacc" || validationBodies[0]["storage-credential"] == nil {
+				return fmt.Errorf("unexpected validation request, got %v", validationBodies[0])
+			}
+			return nil
+		},
+	})
+}
+
+func TestAccLakekeeperWarehouse_StorageChangeStrategy(t *testing.T) {
+
+	rName := acctest.RandString(8)
+
+	project := testutil.CreateProject(t)
+
+	config := `
+				resource "lakekeeper_warehouse" "s3" {
+					name = "%s"
+					project_id = "%s"
+					storage_change_strategy = "%s"
+					storage_profile = {
+						s3 = {
+							bucket = "%s"
+							endpoint = "http://minio:9000/"
+							region = "eu-west-1"
+							key_prefix = "%s"
+							sts_enabled = false
+							remote_signing_url_style = "path"
+							credential = {
+								access_key = {
+									access_key_id = "minio-root-user"
+									secret_access_key = "minio-root-password"
+								}
+							}
+						}
+					}
+				}
+				`
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckLakekeeperWarehouseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(config, rName, project.ID, "fail", "testacc", rName),
+				Check:  resource.TestCheckResourceAttr("lakekeeper_warehouse.s3", "storage_change_strategy", "fail"),
+			},
+			// The bucket cannot be updated in-place
+			{
+				Config:      fmt.Sprintf(config, rName, project.ID, "fail", "testacc-other", rName),
+				ExpectError: regexp.MustCompile("Storage location cannot be updated"),
+			},
+			// The key prefix cannot be updated in-place
+			{
+				Config:      fmt.Sprintf(config, rName, project.ID, "fail", "testacc", rName+"-other"),
+				ExpectError: regexp.MustCompile(`(?s)Storage location cannot be updated.*s3\.key_prefix`),
+			},
+			{
+				Config:             fmt.Sprintf(config, rName, project.ID, "replace", "testacc-other", rName),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPreRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("lakekeeper_warehouse.s3", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccLakekeeperWarehouse_StorageProfileValidation(t *testing.T) {
+	s3Config := `
+	resource "lakekeeper_warehouse" "s3" {
+		name = "invalid"
+		project_id = "invalid"
+		storage_profile = {
+			s3 = {
+				bucket = "testacc"
+				region = "eu-west-1"
+				%s
+			}
+		}
+	}
+	`
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(s3Config, `
+				sts_enabled = true
+				credential = {
+					access_key = {
+						access_key_id = "minio-root-user"
+						secret_access_key = "minio-root-password"
+					}
+				}`),
+				ExpectError: regexp.MustCompile("'sts_role_arn' or 'assume_role_arn' required"),
+			},
+			// the role is ignored by the server when STS is not enabled
+			{
+				Config: fmt.Sprintf(s3Config, `
+				sts_enabled = false
+				sts_role_arn = "arn:aws:iam::123456789012:role/lakekeeper"
+				credential = {
+					access_key = {
+						access_key_id = "minio-root-user"
+						secret_access_key = "minio-root-password"
+					}
+				}`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: fmt.Sprintf(s3Config, `
+				sts_enabled = false
+				flavor = "s3-compat"
+				credential = {
+					access_key = {
+						access_key_id = "minio-root-user"
+						secret_access_key = "minio-root-password"
+					}
+				}`),
+				ExpectError: regexp.MustCompile("'endpoint' required for flavor 's3-compat'"),
+			},
+			{
+				Config: fmt.Sprintf(s3Config, `
+				sts_enabled = false
+				credential = {
+					cloudflare_r2 = {
+						access_key_id = "access-key-id"
+						secret_access_key = "secret-access-key"
+						account_id = "account-id"
+						token = "token"
+					}
+				}`),
+				ExpectError: regexp.MustCompile("'cloudflare_r2' credential requires flavor 's3-compat'"),
+			},
+			{
+				Config: `
+				resource "lakekeeper_warehouse" "gcs" {
+					name = "invalid"
+					project_id = "invalid"
+					storage_profile = {
+						gcs = {
+							bucket = "Invalid_Bucket"
+							credential = {
+								service_account_key = {
+									key = "not-a-json"
+								}
+							}
+						}
+					}
+				}
+				`,
+				ExpectError: regexp.MustCompile("(?s)Invalid GCS bucket name.*Invalid service account key"),
+			},
+		},
+	})
+}
+
+func TestAccLakekeeperWarehouse_ImportCredential(t *testing.T) {
+
+	rName := acctest.RandString(8)
+	rPrefix := acctest.RandString(8)
+
+	project := testutil.CreateProject(t)
+
+	// The warehouse is created outside of Terraform, then imported
+	storage := profile.NewS3StorageSettings("testacc", "local-01",
+		profile.WithEndpoint("http://minio:9000/"),
+		profile.WithPathStyleAccess(),
+		profile.WithS3KeyPrefix(rPrefix),
+	)
+	w, _, err := testutil.TestLakekeeperClient.WarehouseV1(project.ID).Create(t.Context(), &managementv1.CreateWarehouseOptions{
+		Name:              rName,
+		StorageProfile:    storage.AsProfile(),
+		StorageCredential: credential.NewS3CredentialAccessKey("minio-root-user", "minio-root-password").AsCredential(),
+		DeleteProfile:     profile.NewTabularDeleteProfileHard().AsProfile(),
+	})
+	if err != nil {
+		t.Fatalf("could not create test warehouse: %v", err)
+	}
+
+	config := fmt.Sprintf(`
+				resource "lakekeeper_warehouse" "imported" {
+					name = "%s"
+					project_id = "%s"
+					storage_profile = {
+						s3 = {
+							bucket = "testacc"
+							region = "local-01"
+							endpoint = "http://minio:9000/"
+							path_style_access = true
+							key_prefix = "%s"
+							sts_enabled = false
+							credential = {
+								access_key = {
+									access_key_id = "minio-root-user"
+									secret_access_key = "minio-root-password"
+								}
+							}
+						}
+					}
+				}
+				`, rName, project.ID, rPrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckLakekeeperWarehouseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:             config,
+				ResourceName:       "lakekeeper_warehouse.imported",
+				ImportState:        true,
+				ImportStateId:      project.ID + "/" + w.ID,
+				ImportStatePersist: true,
+			},
+			// The credential is adopted in-place
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("lakekeeper_warehouse.imported", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakekeeper_warehouse.imported", "warehouse_id", w.ID),
+					resource.TestCheckResourceAttr("lakekeeper_warehouse.imported", "storage_profile.s3.credential.access_key.access_key_id", "minio-root-user"),
+					resource.TestCheckResourceAttr("lakekeeper_warehouse.imported", "storage_profile.s3.credential.access_key.secret_access_key", "minio-root-password"),
+				),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccLakekeeperWarehouse_disappears(t *testing.T) {
+
+	rName := acctest.RandString(8)
+
+	project := testutil.CreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckLakekeeperWarehouseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "lakekeeper_warehouse" "s3" {
+					name = "%s"
+					project_id = "%s"
+					storage_profile = {
+						s3 = {
+							bucket = "testacc"
+							endpoint = "http://minio:9000/"
+							region = "eu-west-1"
+							sts_enabled = false
+							credential = {
+								access_key = {
+									access_key_id = "minio-root-user"
+									secret_access_key = "minio-root-password"
+								}
+							}
+						}
+					}
+				}
+				`, rName, project.ID),
+				// the warehouse is deleted outside of Terraform, it must be planned for creation
+				Check: func(s *terraform.State) error {
+					rs := s.RootModule().Resources["lakekeeper_warehouse.s3"]
+					_, err := testutil.TestLakekeeperClient.WarehouseV1(project.ID).Delete(context.Background(), rs.Primary.Attributes["warehouse_id"], &managementv1.DeleteWarehouseOptions{
+						Force: core.Ptr(true),
+					})
+					return err
+				},
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func TestAccLakekeeperWarehouse_GCS_SystemIdentity(t *testing.T) {
 
 	rName := acctest.RandString(8)
@@ -605,13 +1084,96 @@ func TestAccLakekeeperWarehouse_NoCreds(t *testing.T) {
 	})
 }
 
+func TestAccLakekeeperWarehouse_forceDestroy(t *testing.T) {
+
+	rName := acctest.RandString(8)
+
+	project := testutil.CreateProject(t)
+
+	config := func(protected, forceDestroy, active bool) string {
+		return fmt.Sprintf(`
+		resource "lakekeeper_warehouse" "s3" {
+			name          = "%s"
+			project_id    = "%s"
+			protected     = %t
+			active        = %t
+			force_destroy = %t
+			storage_profile = {
+				s3 = {
+					bucket            = "testacc"
+					endpoint          = "http://minio:9000/"
+					region            = "eu-west-1"
+					sts_enabled       = false
+					key_prefix        = "%s"
+					credential = {
+						access_key = {
+							access_key_id     = "minio-root-user"
+							secret_access_key = "minio-root-password"
+						}
+					}
+				}
+			}
+		}
+		`, rName, project.ID, protected, active, forceDestroy, rName)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckLakekeeperWarehouseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config(true, false, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakekeeper_warehouse.s3", "protected", "true"),
+					resource.TestCheckResourceAttr("lakekeeper_warehouse.s3", "force_destroy", "false"),
+				),
+			},
+			// the protection is not overridden
+			{
+				Config:      config(true, false, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Warehouse is protected"),
+			},
+			// the content is not dropped
+			{
+				PreConfig: func() {
+					cat, err := testutil.TestLakekeeperClient.CatalogV1(t.Context(), project.ID, rName)
+					if err != nil {
+						t.Fatalf("could not create the Iceberg Catalog client: %v", err)
+					}
+					if err := cat.CreateNamespace(t.Context(), catalog.ToIdentifier(acctest.RandString(8)), nil); err != nil {
+						t.Fatalf("could not create test namespace: %v", err)
+					}
+				},
+				Config: config(false, false, true),
+			},
+			{
+				Config:      config(false, false, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Warehouse is not empty"),
+			},
+			// the inactive warehouse is deleted along with its namespaces, despite the protection
+			{
+				Config: config(true, true, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("lakekeeper_warehouse.s3", "force_destroy", "true"),
+					resource.TestCheckResourceAttr("lakekeeper_warehouse.s3", "active", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLakekeeperWarehouseDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "lakekeeper_warehouse" {
 			continue
 		}
 
-		projectID, warehouseID := splitInternalID(types.StringValue(rs.Primary.ID))
+		projectID, warehouseID, diags := splitInternalID(types.StringValue(rs.Primary.ID))
+		if diags.HasError() {
+			return fmt.Errorf("invalid resource ID %s", rs.Primary.ID)
+		}
 		if _, _, err := testutil.TestLakekeeperClient.WarehouseV1(projectID).Get(context.Background(), warehouseID); err == nil {
 			return fmt.Errorf("Warehouse with id %s still exists", rs.Primary.ID)
 		}
@@ -4,14 +4,14 @@ import (
 	"context"
 	"fmt"
 	"regexp"
-	"strings"
 
 	permissionv1 "github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1/permission"
 	lakekeeper "github.com/baptistegh/go-lakekeeper/pkg/client"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -25,10 +25,19 @@ var (
 	_ resource.Resource                = &lakekeeperWarehouseUserAssignmentResource{}
 	_ resource.ResourceWithConfigure   = &lakekeeperWarehouseUserAssignmentResource{}
 	_ resource.ResourceWithImportState = &lakekeeperWarehouseUserAssignmentResource{}
+	_ resource.ResourceWithIdentity    = &lakekeeperWarehouseUserAssignmentResource{}
+	_ list.ListResourceWithConfigure   = &lakekeeperWarehouseUserAssignmentResource{}
 )
 
+// warehouseUserAssignmentIdentity is the identity of the resource, the import ID is `{{warehouse_id}}/{{user_id}}`.
+var warehouseUserAssignmentIdentity = resourceIdentity{
+	{Name: "warehouse_id", Description: "The ID of the warehouse."},
+	{Name: "user_id", Description: "The ID of the assigned user."},
+}
+
 func init() {
 	registerResource(NewLakekeeperWarehouseUserAssignment)
+	registerListResource(NewLakekeeperWarehouseUserAssignmentListResource)
 }
 
 // NewLakekeeperWarehouseAssignment is a helper function to simplify the provider implementation.
@@ -36,6 +45,11 @@ func NewLakekeeperWarehouseUserAssignment() resource.Resource {
 	return &lakekeeperWarehouseUserAssignmentResource{}
 }
 
+// NewLakekeeperWarehouseUserAssignmentListResource is a helper function to simplify the provider implementation.
+func NewLakekeeperWarehouseUserAssignmentListResource() list.ListResource {
+	return &lakekeeperWarehouseUserAssignmentResource{}
+}
+
 func (r *lakekeeperWarehouseUserAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_warehouse_user_assignment"
 }
@@ -162,6 +176,7 @@ func (r *lakekeeperWarehouseUserAssignmentResource) Create(ctx context.Context,
 	state.Assignments = plan.Assignments
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(warehouseUserAssignmentIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -175,9 +190,17 @@ func (r *lakekeeperWarehouseUserAssignmentResource) Read(ctx context.Context, re
 		return
 	}
 
-	warehouseID, userID := splitInternalID(state.ID)
+	warehouseID, userID, diags := splitInternalID(state.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	assignments, _, err := r.client.PermissionV1().WarehousePermission().GetAssignments(ctx, warehouseID, nil)
+	if isNotFoundError(err) {
+		removeMissingResource(ctx, warehouseUserAssignmentIdentity, req, resp)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read role assignments, %s", err.Error()))
 		return
@@ -201,6 +224,7 @@ func (r *lakekeeperWarehouseUserAssignmentResource) Read(ctx context.Context, re
 
 	state.Assignments = newAssignments
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(warehouseUserAssignmentIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Updates updates the resource in-place.
@@ -252,7 +276,11 @@ func (r *lakekeeperWarehouseUserAssignmentResource) Update(ctx context.Context,
 		})
 	}
 
-	warehouseID, assigneeID := splitInternalID(state.ID)
+	warehouseID, assigneeID, diags := splitInternalID(state.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	if _, err := r.client.PermissionV1().WarehousePermission().Update(ctx, warehouseID, &permissionv1.UpdateWarehousePermissionsOptions{
 		Writes:  writes,
@@ -266,6 +294,7 @@ func (r *lakekeeperWarehouseUserAssignmentResource) Update(ctx context.Context,
 	state.Assignments = plan.Assignments
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(warehouseUserAssignmentIdentity.Set(ctx, resp.State, resp.Identity)...)
 }
 
 // Deletes removes the resource.
@@ -299,27 +328,64 @@ func (r *lakekeeperWarehouseUserAssignmentResource) Delete(ctx context.Context,
 
 	if _, err := r.client.PermissionV1().WarehousePermission().Update(ctx, state.WarehouseID.ValueString(), &permissionv1.UpdateWarehousePermissionsOptions{
 		Deletes: deletes,
-	}); err != nil {
+	}); err != nil && !isNotFoundError(err) {
 		resp.Diagnostics.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to delete role assignment, %v", err.Error()))
+		return
 	}
 
 	resp.State.RemoveResource(ctx)
 }
 
-// ImportState imports the resource into the Terraform state.
+// IdentitySchema defines the identity of the resource, used by import blocks.
+func (r *lakekeeperWarehouseUserAssignmentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = warehouseUserAssignmentIdentity.Schema()
+}
+
+// ImportState imports the resource into the Terraform state, either from its ID or from its identity.
 func (r *lakekeeperWarehouseUserAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Expected format: "warehouse_id/user_id"
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid imp&ort ID format",
-			"Expected format: warehouse_id/user_id",
-		)
+	warehouseUserAssignmentIdentity.ImportState(ctx, req, resp)
+}
+
+// lakekeeperWarehouseUserAssignmentListModel describes the config data model of the list resource.
+type lakekeeperWarehouseUserAssignmentListModel struct {
+	WarehouseID types.String `tfsdk:"warehouse_id"`
+}
+
+// ListResourceConfigSchema defines the config of the list resource, used by list blocks.
+func (r *lakekeeperWarehouseUserAssignmentResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists the users having assignments on a warehouse.",
+		Attributes: map[string]listschema.Attribute{
+			"warehouse_id": listschema.StringAttribute{
+				MarkdownDescription: "The ID of the warehouse.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+// List lists the users having assignments on a warehouse.
+func (r *lakekeeperWarehouseUserAssignmentResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config lakekeeperWarehouseUserAssignmentListModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
 		return
 	}
 
-	resp.State.SetAttribute(ctx, path.Root("warehouse_id"), parts[0])
-	resp.State.SetAttribute(ctx, path.Root("user_id"), parts[1])
+	warehouseID := config.WarehouseID.ValueString()
+
+	assignments, _, err := r.client.PermissionV1().WarehousePermission().GetAssignments(ctx, warehouseID, nil)
+	if err != nil {
+		diags.AddError("Lakekeeper API error occurred", fmt.Sprintf("Unable to read warehouse assignments, %s", err.Error()))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var listed []listedResource
+	for _, id := range assignees(assignments.Assignments, permissionv1.UserType) {
+		listed = append(listed, listedResource{ImportID: warehouseID + "/" + id, DisplayName: id})
+	}
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	stream.Results = listResults(ctx, req, r, listed)
 }
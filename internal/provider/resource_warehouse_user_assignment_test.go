@@ -144,7 +144,10 @@ func testAccCheckLakekeeperWarehouseUserAssignmentDestroy(s *terraform.State) er
 			continue
 		}
 
-		warehouseID, userID := splitInternalID(types.StringValue(rs.Primary.ID))
+		warehouseID, userID, diags := splitInternalID(types.StringValue(rs.Primary.ID))
+		if diags.HasError() {
+			return fmt.Errorf("invalid resource ID %s", rs.Primary.ID)
+		}
 
 		assignments, _, err := testutil.TestLakekeeperClient.PermissionV1().WarehousePermission().GetAssignments(context.Background(), warehouseID, nil)
 		if err != nil {
@@ -15,10 +15,18 @@ type (
 		IsEmpty() bool
 	}
 
+	// WriteOnlyCredsModel is implemented by the storage credentials having write-only secrets.
+	// Write-only values are only available in the configuration, they are never part of the plan or the state.
+	WriteOnlyCredsModel interface {
+		SetWriteOnly(config StorageCredsModel)
+	}
+
 	S3AccessKeyCredsModel struct {
-		AccessKeyID     types.String `tfsdk:"access_key_id"`
-		SecretAccessKey types.String `tfsdk:"secret_access_key"`
-		ExternalID      types.String `tfsdk:"external_id"`
+		AccessKeyID              types.String `tfsdk:"access_key_id"`
+		SecretAccessKey          types.String `tfsdk:"secret_access_key"`
+		SecretAccessKeyWO        types.String `tfsdk:"secret_access_key_wo"`
+		SecretAccessKeyWOVersion types.Int64  `tfsdk:"secret_access_key_wo_version"`
+		ExternalID               types.String `tfsdk:"external_id"`
 	}
 
 	AWSSystemIdentityCredsModel struct {
@@ -33,17 +41,23 @@ type (
 	}
 
 	AZClientCredentialsCredsModel struct {
-		ClientID     types.String `tfsdk:"client_id"`
-		ClientSecret types.String `tfsdk:"client_secret"`
-		TenantID     types.String `tfsdk:"tenant_id"`
+		ClientID              types.String `tfsdk:"client_id"`
+		ClientSecret          types.String `tfsdk:"client_secret"`
+		ClientSecretWO        types.String `tfsdk:"client_secret_wo"`
+		ClientSecretWOVersion types.Int64  `tfsdk:"client_secret_wo_version"`
+		TenantID              types.String `tfsdk:"tenant_id"`
 	}
 
 	AZSharedAccessKeyCredsModel struct {
-		Key types.String `tfsdk:"key"`
+		Key          types.String `tfsdk:"key"`
+		KeyWO        types.String `tfsdk:"key_wo"`
+		KeyWOVersion types.Int64  `tfsdk:"key_wo_version"`
 	}
 
 	GCSServiceAccountKeyCredsModel struct {
-		Key types.String `tfsdk:"key"`
+		Key          types.String `tfsdk:"key"`
+		KeyWO        types.String `tfsdk:"key_wo"`
+		KeyWOVersion types.Int64  `tfsdk:"key_wo_version"`
 	}
 
 	AzureSystemIdentityCredsModel struct {
@@ -65,15 +79,29 @@ var (
 
 	_ StorageCredsModel = (*GCPSystemIdentityCredsModel)(nil)
 	_ StorageCredsModel = (*GCSServiceAccountKeyCredsModel)(nil)
+
+	_ WriteOnlyCredsModel = (*S3AccessKeyCredsModel)(nil)
+	_ WriteOnlyCredsModel = (*AZClientCredentialsCredsModel)(nil)
+	_ WriteOnlyCredsModel = (*AZSharedAccessKeyCredsModel)(nil)
+	_ WriteOnlyCredsModel = (*GCSServiceAccountKeyCredsModel)(nil)
 )
 
+// secretValue returns the value of the secret, falling back to its write-only variant.
+func secretValue(secret, writeOnly types.String) types.String {
+	if secret.IsNull() {
+		return writeOnly
+	}
+	return secret
+}
+
 func (m *S3AccessKeyCredsModel) AsSDK() (credential.CredentialSettings, error) {
 	if m.AccessKeyID.IsNull() || m.AccessKeyID.IsUnknown() {
 		return nil, errors.New("access_key_id is required")
 	}
 
-	if m.SecretAccessKey.IsNull() || m.SecretAccessKey.IsUnknown() {
-		return nil, errors.New("secret_access_key is required")
+	secretAccessKey := secretValue(m.SecretAccessKey, m.SecretAccessKeyWO)
+	if secretAccessKey.IsNull() || secretAccessKey.IsUnknown() {
+		return nil, errors.New("secret_access_key or secret_access_key_wo is required")
 	}
 
 	opts := []credential.S3CredentialAccessKeyOptions{}
@@ -84,7 +112,7 @@ func (m *S3AccessKeyCredsModel) AsSDK() (credential.CredentialSettings, error) {
 
 	creds := credential.NewS3CredentialAccessKey(
 		m.AccessKeyID.ValueString(),
-		m.SecretAccessKey.ValueString(),
+		secretAccessKey.ValueString(),
 		opts...,
 	)
 
@@ -95,6 +123,12 @@ func (m *S3AccessKeyCredsModel) IsEmpty() bool {
 	return m == nil
 }
 
+func (m *S3AccessKeyCredsModel) SetWriteOnly(config StorageCredsModel) {
+	if c, ok := config.(*S3AccessKeyCredsModel); ok && c != nil {
+		m.SecretAccessKeyWO = c.SecretAccessKeyWO
+	}
+}
+
 func (m *AWSSystemIdentityCredsModel) AsSDK() (credential.CredentialSettings, error) {
 	if m.ExternalID.IsNull() || m.ExternalID.IsUnknown() {
 		return nil, errors.New("external_id is required")
@@ -141,8 +175,9 @@ func (m *AZClientCredentialsCredsModel) AsSDK() (credential.CredentialSettings,
 		return nil, errors.New("client_id is required")
 	}
 
-	if m.ClientSecret.IsNull() || m.ClientSecret.IsUnknown() {
-		return nil, errors.New("client_secret is required")
+	clientSecret := secretValue(m.ClientSecret, m.ClientSecretWO)
+	if clientSecret.IsNull() || clientSecret.IsUnknown() {
+		return nil, errors.New("client_secret or client_secret_wo is required")
 	}
 
 	if m.TenantID.IsNull() || m.TenantID.IsUnknown() {
@@ -151,7 +186,7 @@ func (m *AZClientCredentialsCredsModel) AsSDK() (credential.CredentialSettings,
 
 	return credential.NewAZCredentialClientCredentials(
 		m.ClientID.ValueString(),
-		m.ClientSecret.ValueString(),
+		clientSecret.ValueString(),
 		m.TenantID.ValueString(),
 	), nil
 }
@@ -160,12 +195,19 @@ func (m *AZClientCredentialsCredsModel) IsEmpty() bool {
 	return m == nil
 }
 
+func (m *AZClientCredentialsCredsModel) SetWriteOnly(config StorageCredsModel) {
+	if c, ok := config.(*AZClientCredentialsCredsModel); ok && c != nil {
+		m.ClientSecretWO = c.ClientSecretWO
+	}
+}
+
 func (m *AZSharedAccessKeyCredsModel) AsSDK() (credential.CredentialSettings, error) {
-	if m.Key.IsNull() || m.Key.IsUnknown() {
-		return nil, errors.New("key is required")
+	key := secretValue(m.Key, m.KeyWO)
+	if key.IsNull() || key.IsUnknown() {
+		return nil, errors.New("key or key_wo is required")
 	}
 
-	return credential.NewAZCredentialSharedAccessKey(m.Key.ValueString()), nil
+	return credential.NewAZCredentialSharedAccessKey(key.ValueString()), nil
 
 }
 
@@ -173,6 +215,12 @@ func (m *AZSharedAccessKeyCredsModel) IsEmpty() bool {
 	return m == nil
 }
 
+func (m *AZSharedAccessKeyCredsModel) SetWriteOnly(config StorageCredsModel) {
+	if c, ok := config.(*AZSharedAccessKeyCredsModel); ok && c != nil {
+		m.KeyWO = c.KeyWO
+	}
+}
+
 func (m *AzureSystemIdentityCredsModel) AsSDK() (credential.CredentialSettings, error) {
 	return credential.NewAZCredentialManagedIdentity(), nil
 }
@@ -182,13 +230,14 @@ func (m *AzureSystemIdentityCredsModel) IsEmpty() bool {
 }
 
 func (m *GCSServiceAccountKeyCredsModel) AsSDK() (credential.CredentialSettings, error) {
-	if m.Key.IsNull() || m.Key.IsUnknown() {
-		return nil, errors.New("key is required")
+	key := secretValue(m.Key, m.KeyWO)
+	if key.IsNull() || key.IsUnknown() {
+		return nil, errors.New("key or key_wo is required")
 	}
 
 	creds := credential.GCSServiceKey{}
 
-	if err := json.Unmarshal([]byte(m.Key.ValueString()), &creds); err != nil {
+	if err := json.Unmarshal([]byte(key.ValueString()), &creds); err != nil {
 		return nil, err
 	}
 
@@ -199,6 +248,12 @@ func (m *GCSServiceAccountKeyCredsModel) IsEmpty() bool {
 	return m == nil
 }
 
+func (m *GCSServiceAccountKeyCredsModel) SetWriteOnly(config StorageCredsModel) {
+	if c, ok := config.(*GCSServiceAccountKeyCredsModel); ok && c != nil {
+		m.KeyWO = c.KeyWO
+	}
+}
+
 func (m *GCPSystemIdentityCredsModel) AsSDK() (credential.CredentialSettings, error) {
 	return credential.NewGCSCredentialSystemIdentity(), nil
 }
@@ -1,135 +1,256 @@
 package sdk
 
-/*
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1/storage/credential"
+	"github.com/baptistegh/go-lakekeeper/pkg/apis/management/v1/storage/profile"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
-type storageCredentialValidator struct{}
+// gcsBucketRegexp follows the GCS bucket naming rules.
+var gcsBucketRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{1,220}[a-z0-9]$`)
 
-func (v storageCredentialValidator) Description(ctx context.Context) string {
-	return "Validates storage_profile fields depending on the type"
+// storageProfileAsOptions ignores unknown nested objects, they are validated once known.
+var storageProfileAsOptions = basetypes.ObjectAsOptions{
+	UnhandledUnknownAsEmpty: true,
 }
 
-func (v storageCredentialValidator) MarkdownDescription(ctx context.Context) string {
+// S3StorageProfileValidator validates the combinations of fields of an S3 storage profile.
+func S3StorageProfileValidator() validator.Object {
+	return s3StorageProfileValidator{}
+}
+
+// ADLSStorageProfileValidator validates the combinations of fields of an ADLS storage profile.
+func ADLSStorageProfileValidator() validator.Object {
+	return adlsStorageProfileValidator{}
+}
+
+// GCSStorageProfileValidator validates the combinations of fields of a GCS storage profile.
+func GCSStorageProfileValidator() validator.Object {
+	return gcsStorageProfileValidator{}
+}
+
+type s3StorageProfileValidator struct{}
+
+func (v s3StorageProfileValidator) Description(ctx context.Context) string {
+	return "Validates s3 storage profile fields depending on the flavor and the credential type"
+}
+
+func (v s3StorageProfileValidator) MarkdownDescription(ctx context.Context) string {
 	return v.Description(ctx)
 }
 
-func (v storageCredentialValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+func (v s3StorageProfileValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
 	val := req.ConfigValue
 
 	if val.IsNull() || val.IsUnknown() {
 		return
 	}
 
-	var profile = StorageCredentialModel{}
+	var s3 S3StorageProfileModel
 
-	diags := val.As(ctx, &profile, basetypes.ObjectAsOptions{})
+	diags := val.As(ctx, &s3, storageProfileAsOptions)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	switch profile.Type.ValueString() {
-	case "s3_access_key":
-		if profile.AccessKeyID.IsNull() {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("access_key_id"),
-				"'access_key_id' required for type 's3_access_key'",
-				"When 'type' is 's3_access_key', you must set the 'access_key_id' attribute.",
-			)
-		}
-		if profile.SecretAccessKey.IsNull() {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("secret_access_key"),
-				"'secret_access_key' required for type 's3_access_key'",
-				"When 'type' is 's3_access_key', you must set the 'secret_access_key' attribute.",
-			)
-		}
-	case "s3_aws_system_identity":
-		break
-	case "s3_cloudflare_r2":
-		if profile.AccessKeyID.IsNull() {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("access_key_id"),
-				"'access_key_id' required for type 's3_cloudflare_r2'",
-				"When 'type' is 's3_cloudflare_r2', you must set the 'access_key_id' attribute.",
-			)
-		}
-		if profile.SecretAccessKey.IsNull() {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("secret_access_key"),
-				"'secret_access_key' required for type 's3_cloudflare_r2'",
-				"When 'type' is 's3_cloudflare_r2', you must set the 'secret_access_key' attribute.",
-			)
-		}
-		if profile.AccountID.IsNull() {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("account_id"),
-				"'account_id' required for type 's3_cloudflare_r2'",
-				"When 'type' is 's3_cloudflare_r2', you must set the 'account_id' attribute.",
-			)
-		}
-		if profile.Token.IsNull() {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("token"),
-				"'token' required for type 's3_cloudflare_r2'",
-				"When 'type' is 's3_cloudflare_r2', you must set the 'token' attribute.",
-			)
-		}
-	case "az_client_credentials":
-		if profile.ClientID.IsNull() {
+	// flavor is computed by the server when not set, the default one is aws
+	if s3.Flavor.IsUnknown() {
+		return
+	}
+	flavor := profile.AWSFlavor
+	if !s3.Flavor.IsNull() {
+		flavor = profile.S3Flavor(s3.Flavor.ValueString())
+	}
+
+	if s3.STSEnabled.ValueBool() && flavor == profile.AWSFlavor && isUnset(s3.STSRoleARN) && isUnset(s3.AssumeRoleARN) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path.AtName("sts_enabled"),
+			"'sts_role_arn' or 'assume_role_arn' required when STS is enabled",
+			fmt.Sprintf("When 'sts_enabled' is true with the flavor '%s', you must set the 'sts_role_arn' or the 'assume_role_arn' attribute.", profile.AWSFlavor),
+		)
+	}
+
+	// the server accepts the role without STS, it is only ignored
+	if !s3.STSEnabled.IsUnknown() && !s3.STSEnabled.ValueBool() && isSet(s3.STSRoleARN) {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path.AtName("sts_role_arn"),
+			"'sts_role_arn' is ignored when STS is disabled",
+			"'sts_role_arn' is only used when 'sts_enabled' is true.",
+		)
+	}
+
+	if flavor == profile.S3CompatFlavor {
+		if isUnset(s3.Endpoint) && !s3.Endpoint.IsUnknown() {
 			resp.Diagnostics.AddAttributeError(
-				path.Root("client_id"),
-				"'client_id' required for type 'az_client_credentials'",
-				"When 'type' is 'az_client_credentials', you must set the 'client_id' attribute.",
+				req.Path.AtName("endpoint"),
+				fmt.Sprintf("'endpoint' required for flavor '%s'", profile.S3CompatFlavor),
+				fmt.Sprintf("When 'flavor' is '%s', you must set the 'endpoint' attribute.", profile.S3CompatFlavor),
 			)
 		}
-		if profile.ClientSecret.IsNull() {
+
+		if isSet(s3.AWSKMSKeyARN) {
 			resp.Diagnostics.AddAttributeError(
-				path.Root("client_secret"),
-				"'client_secret' required for type 'az_client_credentials'",
-				"When 'type' is 'az_client_credentials', you must set the 'client_secret' attribute.",
+				req.Path.AtName("aws_kms_key_arn"),
+				fmt.Sprintf("'aws_kms_key_arn' not supported for flavor '%s'", profile.S3CompatFlavor),
+				fmt.Sprintf("'aws_kms_key_arn' can only be used with the flavor '%s'.", profile.AWSFlavor),
 			)
 		}
-		if profile.TenantID.IsNull() {
+	}
+
+	if s3.PathStyleAccess.ValueBool() && s3.RemoteSigningURLStyle.ValueString() == string(profile.VirtualHostSigningURLStyle) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path.AtName("remote_signing_url_style"),
+			"Incompatible 'remote_signing_url_style'",
+			fmt.Sprintf("'remote_signing_url_style' cannot be '%s' when 'path_style_access' is enabled, use '%s' or '%s'.", profile.VirtualHostSigningURLStyle, profile.PathSigningURLStyle, profile.AutoSigningURLStyle),
+		)
+	}
+
+	if s3.Credential == nil {
+		return
+	}
+
+	if s3.Credential.CloudflareR2 != nil && flavor != profile.S3CompatFlavor {
+		resp.Diagnostics.AddAttributeError(
+			req.Path.AtName("credential").AtName("cloudflare_r2"),
+			fmt.Sprintf("'cloudflare_r2' credential requires flavor '%s'", profile.S3CompatFlavor),
+			fmt.Sprintf("Cloudflare R2 is not an AWS service, you must set 'flavor' to '%s'.", profile.S3CompatFlavor),
+		)
+	}
+
+	if s3.Credential.AWSSystemIdentity != nil && flavor != profile.AWSFlavor {
+		resp.Diagnostics.AddAttributeError(
+			req.Path.AtName("credential").AtName("aws_system_identity"),
+			fmt.Sprintf("'aws_system_identity' credential requires flavor '%s'", profile.AWSFlavor),
+			fmt.Sprintf("The AWS system identity can only be used with the flavor '%s'.", profile.AWSFlavor),
+		)
+	}
+}
+
+type adlsStorageProfileValidator struct{}
+
+func (v adlsStorageProfileValidator) Description(ctx context.Context) string {
+	return "Validates adls storage profile fields"
+}
+
+func (v adlsStorageProfileValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v adlsStorageProfileValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	val := req.ConfigValue
+
+	if val.IsNull() || val.IsUnknown() {
+		return
+	}
+
+	var adls ADLSStorageProfileModel
+
+	diags := val.As(ctx, &adls, storageProfileAsOptions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isSet(adls.AuthorityHost) {
+		if u, err := url.Parse(adls.AuthorityHost.ValueString()); err != nil || u.Scheme != "https" || u.Host == "" {
 			resp.Diagnostics.AddAttributeError(
-				path.Root("tenant_id"),
-				"'tenant_id' required for type 'az_client_credentials'",
-				"When 'type' is 'az_client_credentials', you must set the 'tenant_id' attribute.",
+				req.Path.AtName("authority_host"),
+				"Invalid 'authority_host'",
+				fmt.Sprintf("'authority_host' must be an https URL, e.g. https://login.microsoftonline.com, got '%s'.", adls.AuthorityHost.ValueString()),
 			)
 		}
-	case "az_shared_access_key":
-		if profile.AZKey.IsNull() {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("az_key"),
-				"'az_key' required for type 'az_shared_access_key'",
-				"When 'type' is 'az_shared_access_key', you must set the 'az_key' attribute.",
-			)
+	}
+
+	if isSet(adls.Host) && strings.Contains(adls.Host.ValueString(), "://") {
+		resp.Diagnostics.AddAttributeError(
+			req.Path.AtName("host"),
+			"Invalid 'host'",
+			fmt.Sprintf("'host' must be a host name without scheme, e.g. dfs.core.windows.net, got '%s'.", adls.Host.ValueString()),
+		)
+	}
+
+	if adls.Credential != nil && adls.Credential.ClientCredentials == nil && isSet(adls.AuthorityHost) {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path.AtName("authority_host"),
+			"'authority_host' is not used",
+			"'authority_host' is only used to authenticate with 'client_credentials'.",
+		)
+	}
+}
+
+type gcsStorageProfileValidator struct{}
+
+func (v gcsStorageProfileValidator) Description(ctx context.Context) string {
+	return "Validates gcs storage profile fields"
+}
+
+func (v gcsStorageProfileValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v gcsStorageProfileValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	val := req.ConfigValue
+
+	if val.IsNull() || val.IsUnknown() {
+		return
+	}
+
+	var gcs GCSStorageProfileModel
+
+	diags := val.As(ctx, &gcs, storageProfileAsOptions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isSet(gcs.Bucket) && !gcsBucketRegexp.MatchString(gcs.Bucket.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path.AtName("bucket"),
+			"Invalid GCS bucket name",
+			fmt.Sprintf("Bucket names must contain only lowercase letters, numbers, dashes, underscores and dots, and start and end with a letter or a number, got '%s'.", gcs.Bucket.ValueString()),
+		)
+	}
+
+	if gcs.Credential == nil || gcs.Credential.ServiceAccountKey == nil {
+		return
+	}
+
+	// the write-only key is validated the same way
+	for name, key := range map[string]types.String{
+		"key":    gcs.Credential.ServiceAccountKey.Key,
+		"key_wo": gcs.Credential.ServiceAccountKey.KeyWO,
+	} {
+		if !isSet(key) {
+			continue
 		}
-	case "az_azure_system_identity":
-		break
-	case "gcs_service_account_key":
-		if profile.Key == nil {
+
+		var serviceKey credential.GCSServiceKey
+		if err := json.Unmarshal([]byte(key.ValueString()), &serviceKey); err != nil {
 			resp.Diagnostics.AddAttributeError(
-				path.Root("key"),
-				"'key' required for type 'gcs_service_account_key'",
-				"When 'type' is 'gcs_service_account_key', you must set the 'key' attribute.",
+				req.Path.AtName("credential").AtName("service_account_key").AtName(name),
+				"Invalid service account key",
+				fmt.Sprintf("The service account key must be the JSON key file of a service account, %v", err),
 			)
 		}
-	case "gcs_gcp_system_identity":
-		break
-	default:
-		resp.Diagnostics.AddAttributeError(
-			path.Root("type"),
-			"Unsupported storage credential type",
-			fmt.Sprintf("The given type '%s' is not supported. Valid %v", profile.Type.ValueString(), ValidStorageCredentialTypes),
-		)
 	}
 }
-*/
+
+// isSet returns true when the value is known and not empty.
+func isSet(v types.String) bool {
+	return !v.IsNull() && !v.IsUnknown() && v.ValueString() != ""
+}
+
+// isUnset returns true when the value is known to be empty.
+func isUnset(v types.String) bool {
+	return !v.IsUnknown() && v.ValueString() == ""
+}
@@ -1,8 +1,11 @@
 package provider
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
 
 	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/sdk"
 
@@ -54,6 +57,105 @@ func (m *lakekeeperWarehouseResourceModel) toWarehouseCreateRequest() (*manageme
 	return &req, nil
 }
 
+// storageChanges reports whether the storage profile and/or the storage credential
+// of the model differ from the ones of the given state.
+// A change of credential_version is always considered as a credential change.
+func (m *lakekeeperWarehouseResourceModel) storageChanges(state *lakekeeperWarehouseResourceModel) (profileChanged bool, credentialChanged bool, err error) {
+	if m.StorageProfile == nil {
+		return false, false, errors.New("storage profile is required")
+	}
+
+	planProfile, err := sdk.OnlyOneStorageProfile(m.StorageProfile.S3StorageProfile, m.StorageProfile.ADLSStorageProfile, m.StorageProfile.GCSStorageProfile)
+	if err != nil {
+		return false, false, err
+	}
+
+	// without a known state, everything must be sent
+	if state == nil || state.StorageProfile == nil {
+		return true, true, nil
+	}
+
+	stateProfile, err := sdk.OnlyOneStorageProfile(state.StorageProfile.S3StorageProfile, state.StorageProfile.ADLSStorageProfile, state.StorageProfile.GCSStorageProfile)
+	if err != nil {
+		return true, true, nil
+	}
+
+	planStorage, err := planProfile.AsSDK()
+	if err != nil {
+		return false, false, err
+	}
+	stateStorage, err := stateProfile.AsSDK()
+	if err != nil {
+		return true, true, nil
+	}
+	profileChanged, err = jsonDiffers(planStorage.AsProfile(), stateStorage.AsProfile())
+	if err != nil {
+		return false, false, err
+	}
+
+	if !m.CredentialVersion.Equal(state.CredentialVersion) {
+		return profileChanged, true, nil
+	}
+
+	planCreds, err := planProfile.GetCredentials()
+	if err != nil {
+		return false, false, err
+	}
+	// The credential is unknown in the state after an import, the API never returns it.
+	// The credential of the plan is adopted as is, credential_version must be changed to send it.
+	stateCreds, err := stateProfile.GetCredentials()
+	if err != nil {
+		return profileChanged, false, nil
+	}
+
+	// Credentials are compared on their Terraform values: write-only secrets are
+	// never part of the plan nor the state, only their version is compared.
+	return profileChanged, !reflect.DeepEqual(planCreds, stateCreds), nil
+}
+
+// setWriteOnlyCredentials copies the write-only credential values of the configuration into the model.
+func (m *lakekeeperWarehouseResourceModel) setWriteOnlyCredentials(config *lakekeeperWarehouseResourceModel) error {
+	if m.StorageProfile == nil || config == nil || config.StorageProfile == nil {
+		return nil
+	}
+
+	planProfile, err := sdk.OnlyOneStorageProfile(m.StorageProfile.S3StorageProfile, m.StorageProfile.ADLSStorageProfile, m.StorageProfile.GCSStorageProfile)
+	if err != nil {
+		return err
+	}
+	configProfile, err := sdk.OnlyOneStorageProfile(config.StorageProfile.S3StorageProfile, config.StorageProfile.ADLSStorageProfile, config.StorageProfile.GCSStorageProfile)
+	if err != nil {
+		return err
+	}
+
+	planCreds, err := planProfile.GetCredentials()
+	if err != nil {
+		return err
+	}
+	configCreds, err := configProfile.GetCredentials()
+	if err != nil {
+		return err
+	}
+
+	if wo, ok := planCreds.(sdk.WriteOnlyCredsModel); ok {
+		wo.SetWriteOnly(configCreds)
+	}
+
+	return nil
+}
+
+func jsonDiffers(a, b any) (bool, error) {
+	ja, err := json.Marshal(a)
+	if err != nil {
+		return false, err
+	}
+	jb, err := json.Marshal(b)
+	if err != nil {
+		return false, err
+	}
+	return !bytes.Equal(ja, jb), nil
+}
+
 // TODO: refactor RefreshFromSettings on datasource and resource
 // because these functions are almost identical
 
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 
 	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider"
+	"github.com/baptistegh/terraform-provider-lakekeeper/internal/provider/api"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 )
 
@@ -31,11 +33,15 @@ func main() {
 	args := os.Args[1:]
 
 	if len(args) > 0 {
-		if args[0] == "version" {
+		switch args[0] {
+		case "version":
 			fmt.Printf("version=%s, commit=%s, date=%s\n", version, commit, date)
 			return
+		case "export":
+			export(args[1:])
+			return
 		}
-		log.Fatalf("Command does not exist: %v, the only command accepted is `version`", args)
+		log.Fatalf("Command does not exist: %v, the only commands accepted are `version` and `export`", args)
 	}
 
 	err := providerserver.Serve(context.Background(), provider.New(version), opts)
@@ -44,3 +50,44 @@ func main() {
 		log.Fatal(err.Error())
 	}
 }
+
+// export writes the configuration of an existing Lakekeeper, the connection
+// settings default to the environment variables of the provider.
+func export(args []string) {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s export [flags]\n\n", os.Args[0])
+		fmt.Fprintln(fs.Output(), "Writes the Terraform configuration of the projects, users, roles, warehouses, namespaces and assignments of a Lakekeeper, with the import blocks adopting them.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+
+	output := fs.String("output", ".", "directory where the .tf files are written")
+	endpoint := fs.String("endpoint", os.Getenv("LAKEKEEPER_ENDPOINT"), "Lakekeeper endpoint, defaults to the `LAKEKEEPER_ENDPOINT` environment variable")
+	authURL := fs.String("auth-url", os.Getenv("LAKEKEEPER_AUTH_URL"), "OIDC token endpoint, defaults to the `LAKEKEEPER_AUTH_URL` environment variable")
+	clientID := fs.String("client-id", os.Getenv("LAKEKEEPER_CLIENT_ID"), "OIDC client ID, defaults to the `LAKEKEEPER_CLIENT_ID` environment variable")
+	clientSecret := fs.String("client-secret", os.Getenv("LAKEKEEPER_CLIENT_SECRET"), "OIDC client secret, defaults to the `LAKEKEEPER_CLIENT_SECRET` environment variable")
+	scopes := fs.String("scopes", "lakekeeper", "comma separated OIDC scopes")
+	caCertFile := fs.String("cacert-file", "", "file containing the CA certificate of the Lakekeeper instance")
+	insecure := fs.Bool("insecure", false, "disable the TLS verification of the Lakekeeper instance")
+
+	// ExitOnError
+	_ = fs.Parse(args)
+
+	config := api.Config{
+		BaseURL:    *endpoint,
+		CACertFile: *caCertFile,
+		Insecure:   *insecure,
+		UserAgent:  fmt.Sprintf("terraform-provider-lakekeeper/%s (export)", version),
+		OIDCClientConfig: api.OIDCClientConfig{
+			AuthURL:      *authURL,
+			ClientID:     *clientID,
+			ClientSecret: *clientSecret,
+			Scopes:       strings.Split(*scopes, ","),
+		},
+	}
+
+	if err := provider.Export(context.Background(), config, *output); err != nil {
+		log.Fatalf("Export failed: %v", err)
+	}
+}